@@ -10,3 +10,9 @@ resource "keycard_application_client_secret" "local_dev" {
   zone_id        = keycard_zone.production.id
   application_id = data.keycard_application.google_mcp.id
 }
+
+# Look up an application by its slug
+data "keycard_application" "billing" {
+  zone_id = keycard_zone.production.id
+  slug    = "billing"
+}
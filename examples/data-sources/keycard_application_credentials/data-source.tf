@@ -0,0 +1,23 @@
+# Inventory every credential of an application for a security review.
+# Secrets are never included.
+data "keycard_application_credentials" "backend" {
+  zone_id        = keycard_zone.production.id
+  application_id = keycard_application.backend.id
+}
+
+output "backend_credentials" {
+  description = "Credential types and identifiers of the backend application"
+  value = [
+    for cred in data.keycard_application_credentials.backend.credentials : {
+      id         = cred.id
+      type       = cred.type
+      identifier = cred.identifier
+    }
+  ]
+}
+
+# Find every workload identity credential in the zone
+data "keycard_application_credentials" "workload_identities" {
+  zone_id = keycard_zone.production.id
+  type    = "token"
+}
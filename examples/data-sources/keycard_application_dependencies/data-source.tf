@@ -0,0 +1,11 @@
+# List everything the MCP gateway can reach, including dependencies
+# created outside Terraform
+data "keycard_application_dependencies" "gateway" {
+  zone_id        = keycard_zone.production.id
+  application_id = keycard_application.mcp_gateway.id
+}
+
+output "gateway_reachable_resources" {
+  description = "Identifiers of the resources the gateway depends on"
+  value       = [for dep in data.keycard_application_dependencies.gateway.dependencies : dep.identifier]
+}
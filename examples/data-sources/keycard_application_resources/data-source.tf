@@ -0,0 +1,14 @@
+# Discover the resources provided by an MCP gateway application
+data "keycard_application_resources" "gateway" {
+  zone_id        = keycard_zone.production.id
+  application_id = keycard_application.mcp_gateway.id
+}
+
+# Allow the agent application to access every resource the gateway provides
+resource "keycard_application_dependency" "agent" {
+  for_each = { for res in data.keycard_application_resources.gateway.resources : res.identifier => res.id }
+
+  zone_id        = keycard_zone.production.id
+  application_id = keycard_application.agent.id
+  resource_id    = each.value
+}
@@ -0,0 +1,20 @@
+# Find every MCP gateway application in the zone
+data "keycard_applications" "gateways" {
+  zone_id = keycard_zone.production.id
+  traits  = ["gateway"]
+}
+
+# Allow each gateway to access the shared backend API
+resource "keycard_application_dependency" "gateway_backend" {
+  for_each = { for app in data.keycard_applications.gateways.applications : app.id => app }
+
+  zone_id        = keycard_zone.production.id
+  application_id = each.key
+  resource_id    = keycard_resource.backend_api.id
+}
+
+# Applications can also be matched by name
+data "keycard_applications" "staging" {
+  zone_id    = keycard_zone.production.id
+  name_regex = "^staging-"
+}
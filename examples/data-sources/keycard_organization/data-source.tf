@@ -0,0 +1,11 @@
+# Read the organization the provider's credentials belong to
+data "keycard_organization" "current" {}
+
+# Select the organization explicitly by slug
+data "keycard_organization" "acme" {
+  slug = "acme"
+}
+
+output "organization_id" {
+  value = data.keycard_organization.current.id
+}
@@ -0,0 +1,19 @@
+# Find the zone's built-in Keycard STS provider without hard-coding its ID
+data "keycard_providers" "sts" {
+  zone_id = keycard_zone.production.id
+  type    = "keycard-sts"
+}
+
+# Use the STS provider as the credential provider for a resource
+resource "keycard_resource" "api" {
+  zone_id                = keycard_zone.production.id
+  name                   = "Internal API"
+  identifier             = "https://api.example.com"
+  credential_provider_id = data.keycard_providers.sts.providers[0].id
+}
+
+# List every external identity provider in the zone
+data "keycard_providers" "external" {
+  zone_id = keycard_zone.production.id
+  type    = "external"
+}
@@ -0,0 +1,10 @@
+# Find every resource whose credentials are issued by the legacy Google provider
+data "keycard_resources" "legacy_google" {
+  zone_id                = keycard_zone.production.id
+  credential_provider_id = keycard_provider.google_legacy.id
+}
+
+output "resources_to_migrate" {
+  description = "Resources that still use the legacy Google provider"
+  value       = { for res in data.keycard_resources.legacy_google.resources : res.identifier => res.id }
+}
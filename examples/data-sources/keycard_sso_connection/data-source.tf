@@ -0,0 +1,11 @@
+# Read the organization's SSO connection without managing it
+data "keycard_sso_connection" "current" {}
+
+output "sso_issuer" {
+  description = "Issuer of the organization's identity provider, or null when SSO is disabled"
+  value       = data.keycard_sso_connection.current.enabled ? data.keycard_sso_connection.current.identifier : null
+}
+
+output "sso_client_id" {
+  value = data.keycard_sso_connection.current.client_id
+}
@@ -4,6 +4,17 @@ data "keycard_zone" "production" {
   id = "my-zone-id"
 }
 
+# Zones can also be looked up by their slug
+data "keycard_zone" "staging" {
+  slug = "staging"
+}
+
+# Slugs and timestamps are available for building console links and reports
+output "zone_created_at" {
+  description = "Creation time of the staging zone"
+  value       = data.keycard_zone.staging.created_at
+}
+
 # Use zone OAuth2 settings when configuring external OAuth applications
 output "zone_redirect_uri" {
   description = "Redirect URI to use when configuring external OAuth apps"
@@ -14,3 +25,19 @@ output "zone_issuer_uri" {
   description = "OAuth2 issuer URI for the zone"
   value       = data.keycard_zone.production.oauth2.issuer_uri
 }
+
+# Resource servers validate zone-issued tokens against the zone's JWKS
+output "zone_jwks_uri" {
+  description = "JWKS URI publishing the zone's token signing keys"
+  value       = data.keycard_zone.production.oauth2.jwks_uri
+}
+
+output "zone_token_endpoint" {
+  description = "OAuth2 token endpoint for the zone"
+  value       = data.keycard_zone.production.oauth2.token_endpoint
+}
+
+output "zone_openid_configuration" {
+  description = "OpenID Connect discovery document for the zone"
+  value       = data.keycard_zone.production.openid.provider_configuration
+}
@@ -0,0 +1,18 @@
+# List every zone in the organization
+data "keycard_zones" "all" {}
+
+# Register the corporate identity provider in every zone
+resource "keycard_provider" "okta" {
+  for_each = { for zone in data.keycard_zones.all.zones : zone.id => zone }
+
+  zone_id       = each.key
+  name          = "Okta"
+  identifier    = "https://integrator-5548280.okta.com"
+  client_id     = var.okta_oauth_client_id
+  client_secret = var.okta_oauth_client_secret
+}
+
+output "zone_issuer_uris" {
+  description = "OAuth2 issuer URI for every zone, keyed by zone name"
+  value       = { for zone in data.keycard_zones.all.zones : zone.name => zone.oauth2.issuer_uri }
+}
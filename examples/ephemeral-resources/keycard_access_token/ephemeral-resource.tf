@@ -0,0 +1,26 @@
+# Access token for a Kubernetes cluster protected by Keycard, obtained with the client
+# credentials grant. The token is only used during the run and never stored.
+ephemeral "keycard_access_token" "deploy" {
+  zone_id       = keycard_zone.production.id
+  client_id     = var.deploy_client_id
+  client_secret = var.deploy_client_secret
+  resource      = keycard_resource.cluster.identifier
+  scopes        = ["deploy:write"]
+}
+
+provider "kubernetes" {
+  host  = "https://k8s.internal.example.com"
+  token = ephemeral.keycard_access_token.deploy.access_token
+}
+
+# Workload running in EKS, authenticating with its service account token
+# through a keycard_application_workload_identity credential
+ephemeral "keycard_access_token" "workload" {
+  zone_id          = keycard_zone.production.id
+  client_assertion = file("/var/run/secrets/eks.amazonaws.com/serviceaccount/token")
+  audience         = "https://vault.example.com"
+}
+
+provider "vault" {
+  token = ephemeral.keycard_access_token.workload.access_token
+}
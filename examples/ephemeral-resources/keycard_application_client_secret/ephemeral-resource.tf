@@ -0,0 +1,18 @@
+# Throwaway client credentials for a single CI run. The credential is deleted
+# when Terraform finishes, and the client_secret never reaches the state.
+ephemeral "keycard_application_client_secret" "ci" {
+  zone_id        = keycard_zone.production.id
+  application_id = keycard_application.ci_pipeline.id
+}
+
+# Hand the credentials to another provider for the duration of the run
+provider "vault" {
+  auth_login {
+    path = "auth/keycard/login"
+
+    parameters = {
+      client_id     = ephemeral.keycard_application_client_secret.ci.client_id
+      client_secret = ephemeral.keycard_application_client_secret.ci.client_secret
+    }
+  }
+}
@@ -62,3 +62,17 @@ resource "keycard_application" "api_gateway" {
   # The gateway trait enables gateway-specific behaviors and workflows
   traits = ["gateway"]
 }
+
+# Reporting service with its dependencies declared inline
+# Dependencies are sent with the create request and reconciled in place on update
+resource "keycard_application" "reporting_service" {
+  name        = "Reporting Service"
+  identifier  = "https://reporting.example.com"
+  zone_id     = keycard_zone.dev.id
+  description = "Builds reports from calendar and drive data"
+
+  dependencies = [
+    var.google_calendar_resource_id,
+    var.google_drive_resource_id,
+  ]
+}
@@ -0,0 +1,10 @@
+# Application client secrets can be imported using the format: zones/{zone-id}/application-credentials/{credential-id}
+# The client_secret cannot be retrieved after creation and is not set for imported credentials
+import {
+  to = keycard_application_client_secret.example
+  id = "zones/zone-id-123/application-credentials/credential-id-abc"
+}
+
+resource "keycard_application_client_secret" "example" {
+  # Configuration will be populated after import
+}
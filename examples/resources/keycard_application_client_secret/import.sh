@@ -0,0 +1 @@
+terraform import keycard_application_client_secret.example zones/{zone-id}/application-credentials/{credential-id}
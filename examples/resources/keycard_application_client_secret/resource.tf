@@ -30,3 +30,23 @@ resource "aws_secretsmanager_secret_version" "mcp_credentials" {
     client_secret = keycard_application_client_secret.google_mcp_server.client_secret
   })
 }
+
+# Rotate the secret every 30 days, keeping the previous secret valid for
+# another day so consumers can pick up the new one
+resource "keycard_application_client_secret" "rotated" {
+  zone_id        = keycard_zone.production.id
+  application_id = keycard_application.google_mcp_server.id
+
+  rotation = {
+    rotate_after = "720h"
+
+    # Changing any keeper also rotates the secret
+    keepers = {
+      version = "1"
+    }
+
+    overlap = {
+      duration = "24h"
+    }
+  }
+}
@@ -0,0 +1,57 @@
+# Client ID and client secret pair for the OAuth 2.0 client credentials flow
+resource "keycard_application_credential" "backend_secret" {
+  zone_id        = keycard_zone.production.id
+  application_id = keycard_application.backend_service.id
+  type           = "password"
+}
+
+# Workload identity for a backend service running in EKS with a specific service account
+resource "keycard_application_credential" "backend_workload" {
+  zone_id        = keycard_zone.production.id
+  application_id = keycard_application.backend_service.id
+  type           = "token"
+
+  token = {
+    provider_id = keycard_provider.eks.id
+    subject     = "system:serviceaccount:production:backend-service-sa"
+  }
+}
+
+# Signed JWT assertions verified against the application's published keys
+resource "keycard_application_credential" "backend_jwks" {
+  zone_id        = keycard_zone.production.id
+  application_id = keycard_application.backend_service.id
+  type           = "public-key"
+
+  public_key = {
+    jwks_uri = "https://backend.example.com/.well-known/jwks.json"
+  }
+}
+
+# Client ID metadata document, which can be updated in place
+resource "keycard_application_credential" "mcp_client" {
+  zone_id        = keycard_zone.production.id
+  application_id = keycard_application.mcp_client.id
+  type           = "url"
+
+  url = {
+    url = "https://mcp-client.example.com/client-metadata.json"
+  }
+}
+
+# Public client for a CLI tool
+resource "keycard_application_credential" "cli" {
+  zone_id        = keycard_zone.production.id
+  application_id = keycard_application.cli.id
+  type           = "public"
+
+  public = {
+    identifier = "keycard-cli"
+  }
+}
+
+# Move an existing type-specific credential resource without recreating the credential
+moved {
+  from = keycard_application_client_secret.backend
+  to   = keycard_application_credential.backend_secret
+}
@@ -0,0 +1,8 @@
+# Single-page applications and CLI tools cannot keep a secret, so they are
+# registered as public OAuth clients. A public credential gives the
+# application a client ID with no client secret; these clients must use PKCE.
+resource "keycard_application_public_credential" "cli" {
+  zone_id        = keycard_zone.dev.id
+  application_id = keycard_application.cli.id
+  identifier     = "example-cli"
+}
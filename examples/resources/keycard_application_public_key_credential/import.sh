@@ -0,0 +1 @@
+terraform import keycard_application_public_key_credential.example zones/{zone-id}/application-credentials/{credential-id}
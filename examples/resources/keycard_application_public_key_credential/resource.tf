@@ -0,0 +1,10 @@
+# Applications that hold their own signing keys can authenticate to a zone
+# with signed JWT assertions (private_key_jwt) instead of a shared secret.
+#
+# The zone verifies each assertion against the public keys published at the
+# application's JWKS URI.
+resource "keycard_application_public_key_credential" "backend" {
+  zone_id        = keycard_zone.dev.id
+  application_id = keycard_application.backend.id
+  jwks_uri       = "https://backend.example.com/.well-known/jwks.json"
+}
@@ -29,9 +29,58 @@ resource "keycard_provider" "okta" {
   client_secret = var.okta_oauth_client_secret
 }
 
+# Microsoft Entra ID Provider with explicit protocol configuration
+# Token validation needs the JWKS URI and the supported scopes
+resource "keycard_provider" "entra" {
+  zone_id       = keycard_zone.dev.id
+  name          = "Microsoft Entra ID"
+  identifier    = "https://login.microsoftonline.com/${var.entra_tenant_id}/v2.0"
+  client_id     = var.entra_client_id
+  client_secret = var.entra_client_secret
+
+  oauth2 = {
+    authorization_endpoint           = "https://login.microsoftonline.com/${var.entra_tenant_id}/oauth2/v2.0/authorize"
+    token_endpoint                   = "https://login.microsoftonline.com/${var.entra_tenant_id}/oauth2/v2.0/token"
+    jwks_uri                         = "https://login.microsoftonline.com/${var.entra_tenant_id}/discovery/v2.0/keys"
+    code_challenge_methods_supported = ["S256"]
+    scopes_supported                 = ["openid", "profile", "email", "offline_access"]
+  }
+
+  openid = {
+    userinfo_endpoint = "https://graph.microsoft.com/oidc/userinfo"
+  }
+}
+
+# Auth0 Provider with endpoints discovered from its OpenID Connect configuration
+# Explicitly set endpoints take precedence over discovered values
+resource "keycard_provider" "auth0" {
+  zone_id       = keycard_zone.dev.id
+  name          = "Auth0"
+  identifier    = "https://example.us.auth0.com/"
+  client_id     = var.auth0_client_id
+  client_secret = var.auth0_client_secret
+
+  discovery = {}
+}
+
 # Configure the zone to use Okta as the user identity provider
 # Users will authenticate through Okta when accessing resources in this zone
 resource "keycard_zone_user_identity_config" "production" {
   zone_id     = keycard_zone.dev.id
   provider_id = keycard_provider.okta.id
 }
+
+# Adopt the zone's built-in Keycard Vault provider
+# Keycard-managed providers cannot be created, only imported; destroying them
+# only removes them from the Terraform state
+import {
+  to = keycard_provider.vault
+  id = "zones/zone-id-123/providers/vault-provider-id"
+}
+
+resource "keycard_provider" "vault" {
+  zone_id    = keycard_zone.dev.id
+  type       = "keycard-vault"
+  name       = "Keycard Vault"
+  identifier = var.vault_provider_identifier
+}
@@ -36,6 +36,21 @@ resource "keycard_zone" "encrypted" {
   }
 }
 
+# Zone served from a custom domain
+# The zone exposes the CNAME record that must be created for the domain
+resource "keycard_zone" "branded" {
+  name  = "Branded"
+  cname = "auth.example.com"
+}
+
+resource "aws_route53_record" "branded" {
+  zone_id = var.route53_zone_id
+  name    = keycard_zone.branded.dns_record_name
+  type    = "CNAME"
+  ttl     = 300
+  records = [keycard_zone.branded.dns_record_target]
+}
+
 # Using zone OAuth2 redirect URI with external OAuth providers
 # The zone provides a redirect_uri that can be used when configuring OAuth apps
 resource "okta_app_oauth" "example" {
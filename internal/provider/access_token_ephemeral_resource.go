@@ -0,0 +1,353 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/keycardai/terraform-provider-keycard/internal/client"
+)
+
+const (
+	// grantTypeClientCredentials is the RFC 6749 client credentials grant.
+	grantTypeClientCredentials = "client_credentials"
+
+	// grantTypeTokenExchange is the RFC 8693 token exchange grant.
+	grantTypeTokenExchange = "urn:ietf:params:oauth:grant-type:token-exchange"
+
+	// clientAssertionTypeJWTBearer is the RFC 7523 client assertion type for JWT client authentication.
+	clientAssertionTypeJWTBearer = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+
+	// tokenTypeAccessToken is the RFC 8693 token type of OAuth 2.0 access tokens.
+	tokenTypeAccessToken = "urn:ietf:params:oauth:token-type:access_token"
+)
+
+// tokenHTTPClient requests access tokens from zone token endpoints. Client credentials are sent
+// in the request itself, so the client is not authenticated against the Keycard API.
+var tokenHTTPClient client.HttpRequestDoer = client.NewLoggingHTTPClient(&http.Client{Timeout: 10 * time.Second})
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ ephemeral.EphemeralResource              = &AccessTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &AccessTokenEphemeralResource{}
+)
+
+func NewAccessTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &AccessTokenEphemeralResource{}
+}
+
+// AccessTokenEphemeralResource defines the ephemeral resource implementation.
+type AccessTokenEphemeralResource struct {
+	client *client.ClientWithResponses
+}
+
+// AccessTokenEphemeralModel describes the ephemeral access token data model.
+type AccessTokenEphemeralModel struct {
+	ZoneID           types.String `tfsdk:"zone_id"`
+	ClientID         types.String `tfsdk:"client_id"`
+	ClientSecret     types.String `tfsdk:"client_secret"`
+	ClientAssertion  types.String `tfsdk:"client_assertion"`
+	SubjectToken     types.String `tfsdk:"subject_token"`
+	SubjectTokenType types.String `tfsdk:"subject_token_type"`
+	Resource         types.String `tfsdk:"resource"`
+	Audience         types.String `tfsdk:"audience"`
+	Scopes           types.List   `tfsdk:"scopes"`
+	TokenEndpoint    types.String `tfsdk:"token_endpoint"`
+	AccessToken      types.String `tfsdk:"access_token"`
+	TokenType        types.String `tfsdk:"token_type"`
+	ExpiresAt        types.String `tfsdk:"expires_at"`
+}
+
+// accessTokenResponse holds the fields of a successful RFC 6749 token response.
+type accessTokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+// accessTokenErrorResponse holds the fields of an RFC 6749 error response.
+type accessTokenErrorResponse struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+func (r *AccessTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_token"
+}
+
+func (r *AccessTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Requests an access token from the token endpoint of a Keycard zone, so that other providers can authenticate with it during a Terraform run. " +
+			"The application authenticates with a client secret or a workload assertion. " +
+			"Without a `subject_token` the client credentials grant is used, otherwise the `subject_token` is exchanged using RFC 8693 token exchange. " +
+			"The access token is never stored in the Terraform state or plan. Requires Terraform 1.10 or later.",
+
+		Attributes: map[string]schema.Attribute{
+			"zone_id": schema.StringAttribute{
+				MarkdownDescription: "The zone whose token endpoint issues the access token.",
+				Required:            true,
+			},
+			"client_id": schema.StringAttribute{
+				MarkdownDescription: "The OAuth 2.0 client ID of the application. Required with `client_secret`.",
+				Optional:            true,
+			},
+			"client_secret": schema.StringAttribute{
+				MarkdownDescription: "The OAuth 2.0 client secret of the application. Exactly one of `client_secret` or `client_assertion` must be set.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_id")),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("client_assertion")),
+				},
+			},
+			"client_assertion": schema.StringAttribute{
+				MarkdownDescription: "A signed JWT issued to the workload, such as a Kubernetes service account token, that authenticates the application through its workload identity credential.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"subject_token": schema.StringAttribute{
+				MarkdownDescription: "A token to exchange for the access token using RFC 8693 token exchange. When not set, the client credentials grant is used.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"subject_token_type": schema.StringAttribute{
+				MarkdownDescription: "The RFC 8693 type of `subject_token`. Defaults to `" + tokenTypeAccessToken + "`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("subject_token")),
+				},
+			},
+			"resource": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the resource the access token is for, as defined by RFC 8707.",
+				Optional:            true,
+			},
+			"audience": schema.StringAttribute{
+				MarkdownDescription: "The audience the access token is for.",
+				Optional:            true,
+			},
+			"scopes": schema.ListAttribute{
+				MarkdownDescription: "The scopes to request for the access token.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"token_endpoint": schema.StringAttribute{
+				MarkdownDescription: "The token endpoint of the zone that issued the access token.",
+				Computed:            true,
+			},
+			"access_token": schema.StringAttribute{
+				MarkdownDescription: "The access token.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"token_type": schema.StringAttribute{
+				MarkdownDescription: "The type of the access token, such as `Bearer`.",
+				Computed:            true,
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "The time the access token expires, in RFC 3339 format. Not set when the token endpoint does not report an expiry.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *AccessTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ClientWithResponses)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *client.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *AccessTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data AccessTokenEphemeralModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Look up the token endpoint of the zone
+	getResp, err := r.client.GetZoneWithResponse(ctx, data.ZoneID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read zone, got error: %s", err))
+		return
+	}
+
+	if getResp.StatusCode() == 404 {
+		resp.Diagnostics.AddError(
+			"Zone Not Found",
+			fmt.Sprintf("Unable to find zone with ID %s. The zone may have been deleted or does not exist.", data.ZoneID.ValueString()),
+		)
+		return
+	}
+
+	if getResp.StatusCode() != 200 {
+		resp.Diagnostics.AddError(
+			"API Error",
+			fmt.Sprintf("Unable to read zone, got status %d: %s", getResp.StatusCode(), string(getResp.Body)),
+		)
+		return
+	}
+
+	if getResp.JSON200 == nil {
+		resp.Diagnostics.AddError("API Error", "Unable to read zone, no response body")
+		return
+	}
+
+	tokenEndpoint := getResp.JSON200.Protocols.Oauth2.TokenEndpoint
+	if tokenEndpoint == "" {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Zone %s has no token endpoint", data.ZoneID.ValueString()))
+		return
+	}
+
+	form, diags := accessTokenRequestForm(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	token, err := requestAccessToken(ctx, tokenHTTPClient, tokenEndpoint, data.ClientID.ValueString(), data.ClientSecret.ValueString(), form)
+	if err != nil {
+		resp.Diagnostics.AddError("Token Request Error", fmt.Sprintf("Unable to obtain an access token from %s, got error: %s", tokenEndpoint, err))
+		return
+	}
+
+	data.TokenEndpoint = types.StringValue(tokenEndpoint)
+	data.AccessToken = types.StringValue(token.AccessToken)
+	data.TokenType = types.StringValue(token.TokenType)
+	data.ExpiresAt = types.StringNull()
+	if token.ExpiresIn > 0 {
+		data.ExpiresAt = types.StringValue(time.Now().Add(time.Duration(token.ExpiresIn) * time.Second).UTC().Format(time.RFC3339))
+	}
+
+	// Save data into the ephemeral result
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// accessTokenRequestForm builds the token request parameters for the configured grant. Client secrets
+// are not part of the form, they are sent using HTTP Basic authentication.
+func accessTokenRequestForm(ctx context.Context, data AccessTokenEphemeralModel) (url.Values, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	form := url.Values{}
+
+	if data.SubjectToken.IsNull() {
+		form.Set("grant_type", grantTypeClientCredentials)
+	} else {
+		form.Set("grant_type", grantTypeTokenExchange)
+		form.Set("subject_token", data.SubjectToken.ValueString())
+		form.Set("subject_token_type", tokenTypeAccessToken)
+		if !data.SubjectTokenType.IsNull() {
+			form.Set("subject_token_type", data.SubjectTokenType.ValueString())
+		}
+	}
+
+	// Workloads authenticate with an assertion instead of a client secret
+	if !data.ClientAssertion.IsNull() {
+		form.Set("client_assertion_type", clientAssertionTypeJWTBearer)
+		form.Set("client_assertion", data.ClientAssertion.ValueString())
+		if !data.ClientID.IsNull() {
+			form.Set("client_id", data.ClientID.ValueString())
+		}
+	}
+
+	if !data.Resource.IsNull() {
+		form.Set("resource", data.Resource.ValueString())
+	}
+
+	if !data.Audience.IsNull() {
+		form.Set("audience", data.Audience.ValueString())
+	}
+
+	if !data.Scopes.IsNull() {
+		var scopes []string
+		diags.Append(data.Scopes.ElementsAs(ctx, &scopes, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		if len(scopes) > 0 {
+			form.Set("scope", strings.Join(scopes, " "))
+		}
+	}
+
+	return form, diags
+}
+
+// requestAccessToken posts the token request to the token endpoint. When a client secret is given,
+// the client authenticates using HTTP Basic authentication as described in RFC 6749 section 2.3.1.
+func requestAccessToken(ctx context.Context, httpClient client.HttpRequestDoer, tokenEndpoint, clientID, clientSecret string, form url.Values) (*accessTokenResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("unable to build token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	if clientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(clientID), url.QueryEscape(clientSecret))
+	}
+
+	httpResp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to send token request: %w", err)
+	}
+	defer httpResp.Body.Close()
+
+	// Token responses are small, anything larger is not a token response
+	body, err := io.ReadAll(io.LimitReader(httpResp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read token response: %w", err)
+	}
+
+	if httpResp.StatusCode != http.StatusOK {
+		var errResp accessTokenErrorResponse
+		if err := json.Unmarshal(body, &errResp); err == nil && errResp.Error != "" {
+			if errResp.ErrorDescription != "" {
+				return nil, fmt.Errorf("got status %d: %s: %s", httpResp.StatusCode, errResp.Error, errResp.ErrorDescription)
+			}
+			return nil, fmt.Errorf("got status %d: %s", httpResp.StatusCode, errResp.Error)
+		}
+
+		return nil, fmt.Errorf("got status %d", httpResp.StatusCode)
+	}
+
+	var token accessTokenResponse
+	if err := json.Unmarshal(body, &token); err != nil {
+		return nil, fmt.Errorf("unable to parse token response: %w", err)
+	}
+
+	if token.AccessToken == "" {
+		return nil, errors.New("token response has no access_token")
+	}
+
+	return &token, nil
+}
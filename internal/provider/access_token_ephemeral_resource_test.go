@@ -0,0 +1,227 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// newTestTokenServer stands in for a zone token endpoint. It issues an access token to the client
+// with the given secret or to any client presenting an assertion, and records the last request form.
+func newTestTokenServer(t *testing.T, clientID, clientSecret string, form *url.Values) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/oauth/token" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		if err := r.ParseForm(); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		*form = r.PostForm
+
+		w.Header().Set("Content-Type", "application/json")
+
+		id, secret, ok := r.BasicAuth()
+		authenticated := ok && id == url.QueryEscape(clientID) && secret == url.QueryEscape(clientSecret)
+		if !authenticated && r.PostForm.Get("client_assertion") == "" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = fmt.Fprint(w, `{"error": "invalid_client", "error_description": "client authentication failed"}`)
+			return
+		}
+
+		_, _ = fmt.Fprint(w, `{"access_token": "test-access-token", "token_type": "Bearer", "expires_in": 3600}`)
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestAccessTokenRequestForm(t *testing.T) {
+	ctx := context.Background()
+
+	scopes := types.ListValueMust(types.StringType, []attr.Value{
+		types.StringValue("read"),
+		types.StringValue("write"),
+	})
+
+	t.Run("client_credentials", func(t *testing.T) {
+		form, diags := accessTokenRequestForm(ctx, AccessTokenEphemeralModel{
+			ClientID:     types.StringValue("client"),
+			ClientSecret: types.StringValue("secret"),
+			Resource:     types.StringValue("https://api.example.com"),
+			Scopes:       scopes,
+		})
+		if diags.HasError() {
+			t.Fatalf("accessTokenRequestForm returned errors: %v", diags)
+		}
+
+		want := url.Values{
+			"grant_type": {grantTypeClientCredentials},
+			"resource":   {"https://api.example.com"},
+			"scope":      {"read write"},
+		}
+		if form.Encode() != want.Encode() {
+			t.Errorf("accessTokenRequestForm = %q, want %q", form.Encode(), want.Encode())
+		}
+	})
+
+	t.Run("token_exchange", func(t *testing.T) {
+		form, diags := accessTokenRequestForm(ctx, AccessTokenEphemeralModel{
+			ClientAssertion: types.StringValue("workload-jwt"),
+			SubjectToken:    types.StringValue("user-token"),
+			Audience:        types.StringValue("https://api.example.com"),
+			Scopes:          types.ListNull(types.StringType),
+		})
+		if diags.HasError() {
+			t.Fatalf("accessTokenRequestForm returned errors: %v", diags)
+		}
+
+		want := url.Values{
+			"grant_type":            {grantTypeTokenExchange},
+			"subject_token":         {"user-token"},
+			"subject_token_type":    {tokenTypeAccessToken},
+			"client_assertion_type": {clientAssertionTypeJWTBearer},
+			"client_assertion":      {"workload-jwt"},
+			"audience":              {"https://api.example.com"},
+		}
+		if form.Encode() != want.Encode() {
+			t.Errorf("accessTokenRequestForm = %q, want %q", form.Encode(), want.Encode())
+		}
+	})
+}
+
+func TestRequestAccessToken(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("client_secret", func(t *testing.T) {
+		var form url.Values
+		server := newTestTokenServer(t, "client:1", "s3cret/+", &form)
+
+		token, err := requestAccessToken(ctx, server.Client(), server.URL+"/oauth/token", "client:1", "s3cret/+", url.Values{
+			"grant_type": {grantTypeClientCredentials},
+		})
+		if err != nil {
+			t.Fatalf("requestAccessToken returned error: %v", err)
+		}
+
+		if token.AccessToken != "test-access-token" || token.TokenType != "Bearer" || token.ExpiresIn != 3600 {
+			t.Errorf("requestAccessToken = %+v, want the issued token", token)
+		}
+		if form.Get("grant_type") != grantTypeClientCredentials {
+			t.Errorf("token request grant_type = %q, want %q", form.Get("grant_type"), grantTypeClientCredentials)
+		}
+		if form.Has("client_secret") {
+			t.Error("token request sent the client secret in the request body")
+		}
+	})
+
+	t.Run("client_assertion", func(t *testing.T) {
+		var form url.Values
+		server := newTestTokenServer(t, "client", "secret", &form)
+
+		_, err := requestAccessToken(ctx, server.Client(), server.URL+"/oauth/token", "", "", url.Values{
+			"grant_type":            {grantTypeClientCredentials},
+			"client_assertion_type": {clientAssertionTypeJWTBearer},
+			"client_assertion":      {"workload-jwt"},
+		})
+		if err != nil {
+			t.Fatalf("requestAccessToken returned error: %v", err)
+		}
+	})
+
+	t.Run("invalid_client", func(t *testing.T) {
+		var form url.Values
+		server := newTestTokenServer(t, "client", "secret", &form)
+
+		_, err := requestAccessToken(ctx, server.Client(), server.URL+"/oauth/token", "client", "wrong", url.Values{
+			"grant_type": {grantTypeClientCredentials},
+		})
+		if err == nil {
+			t.Fatal("requestAccessToken with a wrong client secret did not return an error")
+		}
+		if !strings.Contains(err.Error(), "invalid_client: client authentication failed") {
+			t.Errorf("requestAccessToken error = %q, want the OAuth error", err)
+		}
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		var form url.Values
+		server := newTestTokenServer(t, "client", "secret", &form)
+
+		if _, err := requestAccessToken(ctx, server.Client(), server.URL+"/token", "client", "secret", url.Values{}); err == nil {
+			t.Error("requestAccessToken against a missing endpoint did not return an error")
+		}
+	})
+}
+
+func TestAccAccessTokenEphemeralResource_clientCredentials(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tftest")
+	zoneName := acctest.RandomWithPrefix("tftest-zone")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithEcho,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			// Request an access token with the application's client credentials
+			{
+				Config: testAccAccessTokenEphemeralResourceConfig_clientCredentials(zoneName, rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("echo.test", "data.access_token"),
+					resource.TestCheckResourceAttrSet("echo.test", "data.token_type"),
+					resource.TestCheckResourceAttrPair(
+						"echo.test", "data.token_endpoint",
+						"keycard_zone.test", "oauth2.token_endpoint",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testAccAccessTokenEphemeralResourceConfig_clientCredentials(zoneName, appName string) string {
+	return fmt.Sprintf(`
+resource "keycard_zone" "test" {
+  name = %[1]q
+}
+
+resource "keycard_application" "test" {
+  name       = %[2]q
+  identifier = "https://%[2]s.example.com"
+  zone_id    = keycard_zone.test.id
+}
+
+ephemeral "keycard_application_client_secret" "test" {
+  zone_id        = keycard_zone.test.id
+  application_id = keycard_application.test.id
+}
+
+ephemeral "keycard_access_token" "test" {
+  zone_id       = keycard_zone.test.id
+  client_id     = ephemeral.keycard_application_client_secret.test.client_id
+  client_secret = ephemeral.keycard_application_client_secret.test.client_secret
+}
+
+provider "echo" {
+  data = ephemeral.keycard_access_token.test
+}
+
+resource "echo" "test" {}
+`, zoneName, appName)
+}
@@ -0,0 +1,231 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/keycardai/terraform-provider-keycard/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ ephemeral.EphemeralResource              = &ApplicationClientSecretEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &ApplicationClientSecretEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithClose     = &ApplicationClientSecretEphemeralResource{}
+)
+
+// applicationClientSecretPrivateKey is the private data key holding the credential to delete on close.
+const applicationClientSecretPrivateKey = "credential"
+
+func NewApplicationClientSecretEphemeralResource() ephemeral.EphemeralResource {
+	return &ApplicationClientSecretEphemeralResource{}
+}
+
+// ApplicationClientSecretEphemeralResource defines the ephemeral resource implementation.
+type ApplicationClientSecretEphemeralResource struct {
+	client *client.ClientWithResponses
+}
+
+// ApplicationClientSecretEphemeralModel describes the ephemeral application client secret data model.
+type ApplicationClientSecretEphemeralModel struct {
+	ID            types.String `tfsdk:"id"`
+	ZoneID        types.String `tfsdk:"zone_id"`
+	ApplicationID types.String `tfsdk:"application_id"`
+	CreatedAt     types.String `tfsdk:"created_at"`
+	ClientID      types.String `tfsdk:"client_id"`
+	ClientSecret  types.String `tfsdk:"client_secret"`
+}
+
+// applicationClientSecretPrivateData identifies the credential created by Open, so Close can delete it.
+type applicationClientSecretPrivateData struct {
+	ZoneID       string `json:"zone_id"`
+	CredentialID string `json:"credential_id"`
+}
+
+func (r *ApplicationClientSecretEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application_client_secret"
+}
+
+func (r *ApplicationClientSecretEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Creates short-lived OAuth 2.0 client credentials (client_id/client_secret pair) for a Keycard application. " +
+			"The credential is created when Terraform opens the ephemeral resource and deleted when Terraform closes it, " +
+			"so the client_secret is never stored in the Terraform state or plan. " +
+			"Use this for one-off credentials, such as those of a single CI run. Requires Terraform 1.10 or later.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the credential.",
+				Computed:            true,
+			},
+			"zone_id": schema.StringAttribute{
+				MarkdownDescription: "The zone the application belongs to.",
+				Required:            true,
+			},
+			"application_id": schema.StringAttribute{
+				MarkdownDescription: "The application to create the client credentials for.",
+				Required:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The time the credential was created, in RFC 3339 format.",
+				Computed:            true,
+			},
+			"client_id": schema.StringAttribute{
+				MarkdownDescription: "The OAuth 2.0 client ID.",
+				Computed:            true,
+			},
+			"client_secret": schema.StringAttribute{
+				MarkdownDescription: "The OAuth 2.0 client secret.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (r *ApplicationClientSecretEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ClientWithResponses)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *client.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ApplicationClientSecretEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data ApplicationClientSecretEphemeralModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build the create request for a password-type credential
+	passwordCreate := client.ApplicationCredentialCreatePassword{
+		ApplicationId: data.ApplicationID.ValueString(),
+		Type:          client.ApplicationCredentialCreatePasswordTypePassword,
+	}
+
+	createReq := client.ApplicationCredentialCreate{}
+	err := createReq.FromApplicationCredentialCreatePassword(passwordCreate)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to construct application client secret request body, got error: %s", err))
+		return
+	}
+
+	// Create the credential
+	createResp, err := r.client.CreateApplicationCredentialWithResponse(ctx, data.ZoneID.ValueString(), createReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create application client secret, got error: %s", err))
+		return
+	}
+
+	if createResp.StatusCode() != 200 {
+		resp.Diagnostics.AddError(
+			"API Error",
+			fmt.Sprintf("Unable to create application client secret, got status %d: %s", createResp.StatusCode(), string(createResp.Body)),
+		)
+		return
+	}
+
+	if createResp.JSON200 == nil {
+		resp.Diagnostics.AddError("API Error", "Unable to create application client secret, no response body")
+		return
+	}
+
+	passwordCred, err := createResp.JSON200.AsApplicationCredentialPassword()
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Expected password credential response, got error: %s", err))
+		return
+	}
+
+	// Remember the credential before anything else can fail, so Close always deletes it
+	private, err := json.Marshal(applicationClientSecretPrivateData{
+		ZoneID:       passwordCred.ZoneId,
+		CredentialID: passwordCred.Id,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to encode application client secret private data, got error: %s", err))
+		resp.Diagnostics.Append(r.deleteCredential(ctx, passwordCred.ZoneId, passwordCred.Id)...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, applicationClientSecretPrivateKey, private)...)
+	if resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(r.deleteCredential(ctx, passwordCred.ZoneId, passwordCred.Id)...)
+		return
+	}
+
+	data.ID = types.StringValue(passwordCred.Id)
+	data.ZoneID = types.StringValue(passwordCred.ZoneId)
+	data.ApplicationID = types.StringValue(passwordCred.ApplicationId)
+	data.CreatedAt = TimestampValue(passwordCred.CreatedAt)
+	data.ClientID = types.StringValue(passwordCred.Identifier)
+	data.ClientSecret = types.StringPointerValue(passwordCred.Password)
+
+	// Save data into the ephemeral result, Terraform does not close resources that failed to open
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(r.deleteCredential(ctx, passwordCred.ZoneId, passwordCred.Id)...)
+	}
+}
+
+func (r *ApplicationClientSecretEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	private, diags := req.Private.GetKey(ctx, applicationClientSecretPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Nothing was created if Open failed before recording the credential
+	if len(private) == 0 {
+		return
+	}
+
+	var data applicationClientSecretPrivateData
+	if err := json.Unmarshal(private, &data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to decode application client secret private data, got error: %s", err))
+		return
+	}
+
+	// Delete the credential even when Terraform is shutting down after an interrupt
+	resp.Diagnostics.Append(r.deleteCredential(context.WithoutCancel(ctx), data.ZoneID, data.CredentialID)...)
+}
+
+// deleteCredential deletes the credential created by Open, treating credentials that no longer exist as deleted.
+func (r *ApplicationClientSecretEphemeralResource) deleteCredential(ctx context.Context, zoneID, credentialID string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	deleteResp, err := r.client.DeleteApplicationCredentialWithResponse(ctx, zoneID, credentialID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to delete application client secret %s, got error: %s", credentialID, err))
+		return diags
+	}
+
+	if deleteResp.StatusCode() != 204 && deleteResp.StatusCode() != 404 {
+		diags.AddError(
+			"API Error",
+			fmt.Sprintf("Unable to delete application client secret %s, got status %d: %s", credentialID, deleteResp.StatusCode(), string(deleteResp.Body)),
+		)
+	}
+
+	return diags
+}
@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccApplicationClientSecretEphemeralResource_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tftest")
+	zoneName := acctest.RandomWithPrefix("tftest-zone")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithEcho,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			// Open the ephemeral client secret and echo it into state
+			{
+				Config: testAccApplicationClientSecretEphemeralResourceConfig_basic(zoneName, rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("echo.test", "data.id"),
+					resource.TestCheckResourceAttrSet("echo.test", "data.client_id"),
+					resource.TestCheckResourceAttrSet("echo.test", "data.client_secret"),
+					resource.TestCheckResourceAttrSet("echo.test", "data.created_at"),
+					resource.TestCheckResourceAttrPair(
+						"echo.test", "data.application_id",
+						"keycard_application.test", "id",
+					),
+				),
+			},
+			// The credential is deleted once Terraform closes the ephemeral resource
+			{
+				Config: testAccApplicationClientSecretEphemeralResourceConfig_closed(zoneName, rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.keycard_application_credentials.test", "credentials.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccApplicationClientSecretEphemeralResourceConfig_basic(zoneName, appName string) string {
+	return fmt.Sprintf(`
+resource "keycard_zone" "test" {
+  name = %[1]q
+}
+
+resource "keycard_application" "test" {
+  name       = %[2]q
+  identifier = "https://%[2]s.example.com"
+  zone_id    = keycard_zone.test.id
+}
+
+ephemeral "keycard_application_client_secret" "test" {
+  zone_id        = keycard_zone.test.id
+  application_id = keycard_application.test.id
+}
+
+provider "echo" {
+  data = ephemeral.keycard_application_client_secret.test
+}
+
+resource "echo" "test" {}
+`, zoneName, appName)
+}
+
+func testAccApplicationClientSecretEphemeralResourceConfig_closed(zoneName, appName string) string {
+	return fmt.Sprintf(`
+resource "keycard_zone" "test" {
+  name = %[1]q
+}
+
+resource "keycard_application" "test" {
+  name       = %[2]q
+  identifier = "https://%[2]s.example.com"
+  zone_id    = keycard_zone.test.id
+}
+
+data "keycard_application_credentials" "test" {
+  zone_id        = keycard_zone.test.id
+  application_id = keycard_application.test.id
+}
+`, zoneName, appName)
+}
@@ -3,17 +3,30 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/keycardai/terraform-provider-keycard/internal/client"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
-var _ resource.Resource = &ApplicationClientSecretResource{}
+var (
+	_ resource.Resource                = &ApplicationClientSecretResource{}
+	_ resource.ResourceWithImportState = &ApplicationClientSecretResource{}
+	_ resource.ResourceWithModifyPlan  = &ApplicationClientSecretResource{}
+)
 
 func NewApplicationClientSecretResource() resource.Resource {
 	return &ApplicationClientSecretResource{}
@@ -26,11 +39,20 @@ type ApplicationClientSecretResource struct {
 
 // ApplicationClientSecretModel describes the application client secret data model.
 type ApplicationClientSecretModel struct {
-	ID            types.String `tfsdk:"id"`
-	ZoneID        types.String `tfsdk:"zone_id"`
-	ApplicationID types.String `tfsdk:"application_id"`
-	ClientID      types.String `tfsdk:"client_id"`
-	ClientSecret  types.String `tfsdk:"client_secret"`
+	ID             types.String `tfsdk:"id"`
+	ZoneID         types.String `tfsdk:"zone_id"`
+	Slug           types.String `tfsdk:"slug"`
+	OrganizationID types.String `tfsdk:"organization_id"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+	UpdatedAt      types.String `tfsdk:"updated_at"`
+	ApplicationID  types.String `tfsdk:"application_id"`
+	ClientID       types.String `tfsdk:"client_id"`
+	ClientSecret   types.String `tfsdk:"client_secret"`
+
+	Rotation                 types.Object `tfsdk:"rotation"`
+	PreviousCredentialID     types.String `tfsdk:"previous_credential_id"`
+	PreviousExpiresAt        types.String `tfsdk:"previous_expires_at"`
+	PreviousRemainingApplies types.Int64  `tfsdk:"previous_remaining_applies"`
 }
 
 func (r *ApplicationClientSecretResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -42,8 +64,8 @@ func (r *ApplicationClientSecretResource) Schema(ctx context.Context, req resour
 		MarkdownDescription: "Manages OAuth 2.0 client credentials (client_id/client_secret pair) for a Keycard application. " +
 			"These credentials can be used for OAuth 2.0 client credentials flow. " +
 			"**Important**: The client_secret is only available immediately after creation. " +
-			"If Terraform state is lost, the resource must be recreated as the secret cannot be retrieved. " +
-			"~> **Note** Import is not supported for this resource.",
+			"Imported credentials keep working, but their client_secret cannot be retrieved and remains unset in the Terraform state. " +
+			"Use the `rotation` attribute to replace the secret on a schedule or on demand, optionally keeping the previous secret valid for a while.",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -53,6 +75,31 @@ func (r *ApplicationClientSecretResource) Schema(ctx context.Context, req resour
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"slug": schema.StringAttribute{
+				MarkdownDescription: "URL-safe identifier of the credential, unique within the zone.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "The organization that owns the credential.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The time the credential was created, in RFC 3339 format.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "The time the credential was last updated, in RFC 3339 format.",
+				Computed:            true,
+			},
 			"zone_id": schema.StringAttribute{
 				MarkdownDescription: "The zone this credential belongs to. Changing this will replace the credential.",
 				Required:            true,
@@ -76,13 +123,78 @@ func (r *ApplicationClientSecretResource) Schema(ctx context.Context, req resour
 				},
 			},
 			"client_secret": schema.StringAttribute{
-				MarkdownDescription: "The OAuth 2.0 client secret. This value is only returned on creation and cannot be retrieved later. Store it securely.",
+				MarkdownDescription: "The OAuth 2.0 client secret. This value is only returned on creation and cannot be retrieved later. Store it securely. " +
+					"Not set for imported credentials.",
+				Computed:  true,
+				Sensitive: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"rotation": schema.SingleNestedAttribute{
+				MarkdownDescription: "Rotates the client secret by creating a new credential, which changes `id`, `client_id` and `client_secret`. " +
+					"Without an `overlap`, the previous credential is deleted as soon as the new one is created.",
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"rotate_after": schema.StringAttribute{
+						MarkdownDescription: "Rotate the secret on the first apply after it is older than this duration, such as `720h`. " +
+							"Valid time units are `s`, `m` and `h`.",
+						Optional: true,
+						Validators: []validator.String{
+							positiveDurationValidator{},
+							stringvalidator.AtLeastOneOf(path.MatchRelative().AtParent().AtName("keepers")),
+						},
+					},
+					"keepers": schema.MapAttribute{
+						MarkdownDescription: "Arbitrary map of values that rotate the secret when any of them changes.",
+						ElementType:         types.StringType,
+						Optional:            true,
+					},
+					"overlap": schema.SingleNestedAttribute{
+						MarkdownDescription: "Keeps the previous credential valid after a rotation, so that its consumers can switch to the new secret. " +
+							"Exactly one of `applies` or `duration` must be set.",
+						Optional: true,
+						Attributes: map[string]schema.Attribute{
+							"applies": schema.Int64Attribute{
+								MarkdownDescription: "Number of applies after the rotation that the previous credential is kept. It is deleted on the last of them.",
+								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
+									int64validator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("duration")),
+								},
+							},
+							"duration": schema.StringAttribute{
+								MarkdownDescription: "Time after the rotation that the previous credential is kept, such as `24h`. It is deleted on the first apply after it has passed.",
+								Optional:            true,
+								Validators: []validator.String{
+									positiveDurationValidator{},
+								},
+							},
+						},
+					},
+				},
+			},
+			"previous_credential_id": schema.StringAttribute{
+				MarkdownDescription: "The credential replaced by the last rotation, while it is kept valid by the rotation `overlap`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"previous_expires_at": schema.StringAttribute{
+				MarkdownDescription: "The time after which the previous credential is deleted, in RFC 3339 format, for overlaps with a `duration`.",
 				Computed:            true,
-				Sensitive:           true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"previous_remaining_applies": schema.Int64Attribute{
+				MarkdownDescription: "The number of applies left before the previous credential is deleted, for overlaps counted in `applies`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
 		},
 	}
 }
@@ -117,41 +229,8 @@ func (r *ApplicationClientSecretResource) Create(ctx context.Context, req resour
 		return
 	}
 
-	// Build the create request for a password-type credential
-	passwordCreate := client.ApplicationCredentialCreatePassword{
-		ApplicationId: data.ApplicationID.ValueString(),
-		Type:          client.ApplicationCredentialCreatePasswordTypePassword,
-	}
-
-	createReq := client.ApplicationCredentialCreate{}
-	err := createReq.FromApplicationCredentialCreatePassword(passwordCreate)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to construct application client secret request body, got error: %s", err))
-		return
-	}
-
 	// Create the credential
-	createResp, err := r.client.CreateApplicationCredentialWithResponse(ctx, data.ZoneID.ValueString(), createReq)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create application client secret, got error: %s", err))
-		return
-	}
-
-	if createResp.StatusCode() != 200 {
-		resp.Diagnostics.AddError(
-			"API Error",
-			fmt.Sprintf("Unable to create application client secret, got status %d: %s", createResp.StatusCode(), string(createResp.Body)),
-		)
-		return
-	}
-
-	if createResp.JSON200 == nil {
-		resp.Diagnostics.AddError("API Error", "Unable to create application client secret, no response body")
-		return
-	}
-
-	// Update the model with the response data
-	resp.Diagnostics.Append(updateApplicationClientSecretModelFromCreateResponse(createResp.JSON200, &data)...)
+	resp.Diagnostics.Append(r.createCredential(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -203,17 +282,90 @@ func (r *ApplicationClientSecretResource) Read(ctx context.Context, req resource
 		return
 	}
 
+	// Stop tracking the previous credential once it is deleted outside of Terraform
+	if !data.PreviousCredentialID.IsNull() {
+		previousResp, err := r.client.GetApplicationCredentialWithResponse(ctx, data.ZoneID.ValueString(), data.PreviousCredentialID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read previous application client secret, got error: %s", err))
+			return
+		}
+
+		if previousResp.StatusCode() == 404 {
+			clearPreviousCredential(&data)
+		}
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *ApplicationClientSecretResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// This resource does not support updates - all attributes require replacement
-	// This method should never be called due to RequiresReplace plan modifiers
-	resp.Diagnostics.AddError(
-		"Update Not Supported",
-		"Application client secrets are immutable. Any changes require resource replacement.",
-	)
+	var data, state ApplicationClientSecretModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zoneID := data.ZoneID.ValueString()
+
+	// The credential itself is immutable, updates only rotate it or end the overlap of the previous one
+	if !data.ID.IsUnknown() {
+		if !state.PreviousCredentialID.IsNull() && data.PreviousCredentialID.IsNull() {
+			resp.Diagnostics.Append(r.deleteCredential(ctx, zoneID, state.PreviousCredentialID.ValueString())...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+
+		data.UpdatedAt = state.UpdatedAt
+
+		// Save updated data into Terraform state
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	// Rotate the secret by creating a new credential
+	resp.Diagnostics.Append(r.createCredential(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the credential replaced by this rotation is kept for the overlap
+	obsolete := []string{}
+	if !state.PreviousCredentialID.IsNull() {
+		obsolete = append(obsolete, state.PreviousCredentialID.ValueString())
+	}
+	if data.PreviousCredentialID.IsNull() {
+		obsolete = append(obsolete, state.ID.ValueString())
+	}
+
+	if data.PreviousExpiresAt.IsUnknown() {
+		var rotation ApplicationClientSecretRotationModel
+		var overlap ApplicationClientSecretOverlapModel
+		resp.Diagnostics.Append(data.Rotation.As(ctx, &rotation, basetypes.ObjectAsOptions{})...)
+		resp.Diagnostics.Append(rotation.Overlap.As(ctx, &overlap, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// The duration is validated when planning
+		duration, _ := time.ParseDuration(overlap.Duration.ValueString())
+		data.PreviousExpiresAt = TimestampValue(rotationNow().Add(duration))
+	}
+
+	// Save the new credential first, so it is tracked even if deleting the obsolete ones fails
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, credentialID := range obsolete {
+		resp.Diagnostics.Append(r.deleteCredential(ctx, zoneID, credentialID)...)
+	}
 }
 
 func (r *ApplicationClientSecretResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -227,17 +379,99 @@ func (r *ApplicationClientSecretResource) Delete(ctx context.Context, req resour
 	}
 
 	// Delete the credential
-	deleteResp, err := r.client.DeleteApplicationCredentialWithResponse(ctx, data.ZoneID.ValueString(), data.ID.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete application client secret, got error: %s", err))
+	resp.Diagnostics.Append(r.deleteCredential(ctx, data.ZoneID.ValueString(), data.ID.ValueString())...)
+
+	// Delete the previous credential kept for a rotation overlap
+	if !data.PreviousCredentialID.IsNull() {
+		resp.Diagnostics.Append(r.deleteCredential(ctx, data.ZoneID.ValueString(), data.PreviousCredentialID.ValueString())...)
+	}
+}
+
+func (r *ApplicationClientSecretResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Parse import ID as zones/{zone-id}/application-credentials/{credential-id}
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 4 || parts[0] != "zones" || parts[2] != "application-credentials" || parts[1] == "" || parts[3] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID in format 'zones/{zone-id}/application-credentials/{credential-id}', got: %s", req.ID),
+		)
 		return
 	}
 
+	zoneID := parts[1]
+	credentialID := parts[3]
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone_id"), zoneID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), credentialID)...)
+
+	// The client_secret is only returned on creation, so it remains null for imported credentials
+	resp.Diagnostics.AddWarning(
+		"Client Secret Not Imported",
+		"The client_secret of an application client secret cannot be retrieved after creation, so it is not set in the imported state. "+
+			"The client_id is imported and the credential keeps working. Replace the resource to obtain a new client_secret.",
+	)
+}
+
+// createCredential creates a new password credential for the application and maps it, including
+// the client_secret, into the model.
+func (r *ApplicationClientSecretResource) createCredential(ctx context.Context, data *ApplicationClientSecretModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Build the create request for a password-type credential
+	passwordCreate := client.ApplicationCredentialCreatePassword{
+		ApplicationId: data.ApplicationID.ValueString(),
+		Type:          client.ApplicationCredentialCreatePasswordTypePassword,
+	}
+
+	createReq := client.ApplicationCredentialCreate{}
+	err := createReq.FromApplicationCredentialCreatePassword(passwordCreate)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to construct application client secret request body, got error: %s", err))
+		return diags
+	}
+
+	// Create the credential
+	createResp, err := r.client.CreateApplicationCredentialWithResponse(ctx, data.ZoneID.ValueString(), createReq)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to create application client secret, got error: %s", err))
+		return diags
+	}
+
+	if createResp.StatusCode() != 200 {
+		diags.AddError(
+			"API Error",
+			fmt.Sprintf("Unable to create application client secret, got status %d: %s", createResp.StatusCode(), string(createResp.Body)),
+		)
+		return diags
+	}
+
+	if createResp.JSON200 == nil {
+		diags.AddError("API Error", "Unable to create application client secret, no response body")
+		return diags
+	}
+
+	// Update the model with the response data
+	diags.Append(updateApplicationClientSecretModelFromCreateResponse(createResp.JSON200, data)...)
+
+	return diags
+}
+
+// deleteCredential deletes a credential of the application, treating credentials that no longer exist as deleted.
+func (r *ApplicationClientSecretResource) deleteCredential(ctx context.Context, zoneID, credentialID string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	deleteResp, err := r.client.DeleteApplicationCredentialWithResponse(ctx, zoneID, credentialID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to delete application client secret %s, got error: %s", credentialID, err))
+		return diags
+	}
+
 	if deleteResp.StatusCode() != 204 && deleteResp.StatusCode() != 404 {
-		resp.Diagnostics.AddError(
+		diags.AddError(
 			"API Error",
-			fmt.Sprintf("Unable to delete application client secret, got status %d: %s", deleteResp.StatusCode(), string(deleteResp.Body)),
+			fmt.Sprintf("Unable to delete application client secret %s, got status %d: %s", credentialID, deleteResp.StatusCode(), string(deleteResp.Body)),
 		)
-		return
 	}
+
+	return diags
 }
@@ -6,8 +6,20 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
+func testAccApplicationClientSecretImportStateIdFunc(s *terraform.State) (string, error) {
+	rs, ok := s.RootModule().Resources["keycard_application_client_secret.test"]
+	if !ok {
+		return "", fmt.Errorf("Not found: keycard_application_client_secret.test")
+	}
+	zoneID := rs.Primary.Attributes["zone_id"]
+	id := rs.Primary.ID
+	return fmt.Sprintf("zones/%s/application-credentials/%s", zoneID, id), nil
+}
+
 func TestAccApplicationClientSecretResource_basic(t *testing.T) {
 	rName := acctest.RandomWithPrefix("tftest")
 	zoneName := acctest.RandomWithPrefix("tftest-zone")
@@ -21,6 +33,10 @@ func TestAccApplicationClientSecretResource_basic(t *testing.T) {
 				Config: testAccApplicationClientSecretResourceConfig_basic(zoneName, rName),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("keycard_application_client_secret.test", "id"),
+					resource.TestCheckResourceAttrSet("keycard_application_client_secret.test", "slug"),
+					resource.TestCheckResourceAttrSet("keycard_application_client_secret.test", "organization_id"),
+					resource.TestCheckResourceAttrSet("keycard_application_client_secret.test", "created_at"),
+					resource.TestCheckResourceAttrSet("keycard_application_client_secret.test", "updated_at"),
 					resource.TestCheckResourceAttrSet("keycard_application_client_secret.test", "zone_id"),
 					resource.TestCheckResourceAttrSet("keycard_application_client_secret.test", "application_id"),
 					resource.TestCheckResourceAttrSet("keycard_application_client_secret.test", "client_id"),
@@ -36,11 +52,55 @@ func TestAccApplicationClientSecretResource_basic(t *testing.T) {
 					),
 				),
 			},
+			// ImportState testing, the client_secret cannot be retrieved after creation
+			{
+				ResourceName:            "keycard_application_client_secret.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       testAccApplicationClientSecretImportStateIdFunc,
+				ImportStateVerifyIgnore: []string{"client_secret"},
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
 }
 
+func TestAccApplicationClientSecretResource_import(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tftest")
+	zoneName := acctest.RandomWithPrefix("tftest-zone")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create the credential
+			{
+				Config: testAccApplicationClientSecretResourceConfig_basic(zoneName, rName),
+			},
+			// Replace the state with the imported credential
+			{
+				ResourceName:       "keycard_application_client_secret.test",
+				ImportState:        true,
+				ImportStatePersist: true,
+				ImportStateIdFunc:  testAccApplicationClientSecretImportStateIdFunc,
+			},
+			// The imported credential is neither updated nor replaced
+			{
+				Config: testAccApplicationClientSecretResourceConfig_basic(zoneName, rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("keycard_application_client_secret.test", "client_id"),
+					resource.TestCheckNoResourceAttr("keycard_application_client_secret.test", "client_secret"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccApplicationClientSecretResource_applicationChange(t *testing.T) {
 	rName1 := acctest.RandomWithPrefix("tftest-app1")
 	rName2 := acctest.RandomWithPrefix("tftest-app2")
@@ -111,6 +171,66 @@ func TestAccApplicationClientSecretResource_zoneChange(t *testing.T) {
 	})
 }
 
+func TestAccApplicationClientSecretResource_rotation(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tftest")
+	zoneName := acctest.RandomWithPrefix("tftest-zone")
+
+	var firstID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with rotation keepers
+			{
+				Config: testAccApplicationClientSecretResourceConfig_rotation(zoneName, rName, "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("keycard_application_client_secret.test", "id", func(value string) error {
+						firstID = value
+						return nil
+					}),
+					resource.TestCheckNoResourceAttr("keycard_application_client_secret.test", "previous_credential_id"),
+				),
+			},
+			// Change keepers (should rotate in place and keep the old secret for one more apply)
+			{
+				Config: testAccApplicationClientSecretResourceConfig_rotation(zoneName, rName, "2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("keycard_application_client_secret.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				// The overlap ends on the next apply
+				ExpectNonEmptyPlan: true,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("keycard_application_client_secret.test", "id", func(value string) error {
+						if value == firstID {
+							return fmt.Errorf("expected a new credential, got the original credential %s", value)
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttrWith("keycard_application_client_secret.test", "previous_credential_id", func(value string) error {
+						if value != firstID {
+							return fmt.Errorf("expected previous credential %s, got %s", firstID, value)
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttr("keycard_application_client_secret.test", "previous_remaining_applies", "1"),
+					resource.TestCheckResourceAttrSet("keycard_application_client_secret.test", "client_secret"),
+				),
+			},
+			// Apply again (should remove the old secret)
+			{
+				Config: testAccApplicationClientSecretResourceConfig_rotation(zoneName, rName, "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("keycard_application_client_secret.test", "previous_credential_id"),
+					resource.TestCheckNoResourceAttr("keycard_application_client_secret.test", "previous_remaining_applies"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccApplicationClientSecretResource_multipleCredentials(t *testing.T) {
 	rName := acctest.RandomWithPrefix("tftest")
 	zoneName := acctest.RandomWithPrefix("tftest-zone")
@@ -161,6 +281,35 @@ resource "keycard_application_client_secret" "test" {
 `, zoneName, appName)
 }
 
+func testAccApplicationClientSecretResourceConfig_rotation(zoneName, appName, version string) string {
+	return fmt.Sprintf(`
+resource "keycard_zone" "test" {
+  name = %[1]q
+}
+
+resource "keycard_application" "test" {
+  name       = %[2]q
+  identifier = "https://%[2]s.example.com"
+  zone_id    = keycard_zone.test.id
+}
+
+resource "keycard_application_client_secret" "test" {
+  zone_id        = keycard_zone.test.id
+  application_id = keycard_application.test.id
+
+  rotation = {
+    keepers = {
+      version = %[3]q
+    }
+
+    overlap = {
+      applies = 1
+    }
+  }
+}
+`, zoneName, appName, version)
+}
+
 func testAccApplicationClientSecretResourceConfig_multiple(zoneName, appName string) string {
 	return fmt.Sprintf(`
 resource "keycard_zone" "test" {
@@ -0,0 +1,266 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// rotationNow returns the current time when planning and applying rotations.
+var rotationNow = time.Now
+
+// ApplicationClientSecretRotationModel describes the nested rotation block data model.
+type ApplicationClientSecretRotationModel struct {
+	RotateAfter types.String `tfsdk:"rotate_after"`
+	Keepers     types.Map    `tfsdk:"keepers"`
+	Overlap     types.Object `tfsdk:"overlap"`
+}
+
+func (m ApplicationClientSecretRotationModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"rotate_after": types.StringType,
+		"keepers":      types.MapType{ElemType: types.StringType},
+		"overlap":      types.ObjectType{AttrTypes: ApplicationClientSecretOverlapModel{}.AttributeTypes()},
+	}
+}
+
+// ApplicationClientSecretOverlapModel describes the nested overlap block data model.
+type ApplicationClientSecretOverlapModel struct {
+	Applies  types.Int64  `tfsdk:"applies"`
+	Duration types.String `tfsdk:"duration"`
+}
+
+func (m ApplicationClientSecretOverlapModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"applies":  types.Int64Type,
+		"duration": types.StringType,
+	}
+}
+
+// positiveDurationValidator validates that a string is a positive Go duration, such as "720h".
+type positiveDurationValidator struct{}
+
+func (v positiveDurationValidator) Description(ctx context.Context) string {
+	return "value must be a positive duration, such as \"720h\" or \"90m\""
+}
+
+func (v positiveDurationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v positiveDurationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	duration, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err != nil || duration <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			fmt.Sprintf("Attribute %s %s, got: %s", req.Path, v.Description(ctx), req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+// rotationDue reports whether a secret created at createdAt must be rotated after rotateAfter has elapsed.
+func rotationDue(createdAt, rotateAfter string, now time.Time) (bool, error) {
+	created, err := time.Parse(time.RFC3339, createdAt)
+	if err != nil {
+		return false, fmt.Errorf("invalid creation time %q: %w", createdAt, err)
+	}
+
+	duration, err := time.ParseDuration(rotateAfter)
+	if err != nil {
+		return false, fmt.Errorf("invalid rotate_after %q: %w", rotateAfter, err)
+	}
+
+	return !now.Before(created.Add(duration)), nil
+}
+
+// overlapEnded reports whether the previous secret is no longer needed. Overlaps counted in applies
+// end once the remaining applies run out, and remaining returns the applies left after this one.
+func overlapEnded(expiresAt types.String, remainingApplies types.Int64, now time.Time) (ended bool, remaining types.Int64, err error) {
+	remaining = remainingApplies
+
+	if !expiresAt.IsNull() {
+		expires, err := time.Parse(time.RFC3339, expiresAt.ValueString())
+		if err != nil {
+			return false, remaining, fmt.Errorf("invalid previous_expires_at %q: %w", expiresAt.ValueString(), err)
+		}
+
+		if !now.Before(expires) {
+			return true, types.Int64Null(), nil
+		}
+	}
+
+	if !remainingApplies.IsNull() {
+		if remainingApplies.ValueInt64() <= 1 {
+			return true, types.Int64Null(), nil
+		}
+
+		remaining = types.Int64Value(remainingApplies.ValueInt64() - 1)
+	}
+
+	return false, remaining, nil
+}
+
+// clearPreviousCredential removes the previous secret from the model.
+func clearPreviousCredential(data *ApplicationClientSecretModel) {
+	data.PreviousCredentialID = types.StringNull()
+	data.PreviousExpiresAt = types.StringNull()
+	data.PreviousRemainingApplies = types.Int64Null()
+}
+
+func (r *ApplicationClientSecretResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when the secret is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan ApplicationClientSecretModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A new secret has no previous secret
+	if req.State.Raw.IsNull() {
+		clearPreviousCredential(&plan)
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+		return
+	}
+
+	var state ApplicationClientSecretModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Replacing the secret for another zone or application creates a new secret anyway
+	if !plan.ZoneID.Equal(state.ZoneID) || !plan.ApplicationID.Equal(state.ApplicationID) {
+		return
+	}
+
+	now := rotationNow()
+
+	rotation, hasRotation := ApplicationClientSecretRotationModel{}, !plan.Rotation.IsNull() && !plan.Rotation.IsUnknown()
+	if hasRotation {
+		resp.Diagnostics.Append(plan.Rotation.As(ctx, &rotation, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		rotate, diags := rotationRequired(ctx, rotation, state, now)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if rotate {
+			resp.Diagnostics.Append(planRotatedSecret(ctx, rotation, state, &plan)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+			return
+		}
+	}
+
+	if state.PreviousCredentialID.IsNull() {
+		return
+	}
+
+	// Without a rotation there is no overlap to wait for
+	if !hasRotation || rotation.Overlap.IsNull() {
+		clearPreviousCredential(&plan)
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+		return
+	}
+
+	ended, remaining, err := overlapEnded(state.PreviousExpiresAt, state.PreviousRemainingApplies, now)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid State", fmt.Sprintf("Unable to determine the end of the client secret overlap: %s", err))
+		return
+	}
+
+	if ended {
+		clearPreviousCredential(&plan)
+	} else {
+		plan.PreviousRemainingApplies = remaining
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// rotationRequired reports whether the secret is due for rotation, either because its keepers changed
+// or because it is older than rotate_after.
+func rotationRequired(ctx context.Context, rotation ApplicationClientSecretRotationModel, state ApplicationClientSecretModel, now time.Time) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Adding a rotation to an existing secret does not rotate it, only changes to its keepers do
+	if !state.Rotation.IsNull() {
+		var stateRotation ApplicationClientSecretRotationModel
+		diags.Append(state.Rotation.As(ctx, &stateRotation, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return false, diags
+		}
+
+		if rotation.Keepers.IsUnknown() || !rotation.Keepers.Equal(stateRotation.Keepers) {
+			return true, diags
+		}
+	}
+
+	if rotation.RotateAfter.IsNull() || rotation.RotateAfter.IsUnknown() || state.CreatedAt.IsNull() {
+		return false, diags
+	}
+
+	due, err := rotationDue(state.CreatedAt.ValueString(), rotation.RotateAfter.ValueString(), now)
+	if err != nil {
+		diags.AddError("Invalid State", fmt.Sprintf("Unable to determine whether the client secret is due for rotation: %s", err))
+		return false, diags
+	}
+
+	return due, diags
+}
+
+// planRotatedSecret plans a new secret, keeping the current one as the previous secret when an overlap is configured.
+func planRotatedSecret(ctx context.Context, rotation ApplicationClientSecretRotationModel, state ApplicationClientSecretModel, plan *ApplicationClientSecretModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	plan.ID = types.StringUnknown()
+	plan.Slug = types.StringUnknown()
+	plan.CreatedAt = types.StringUnknown()
+	plan.UpdatedAt = types.StringUnknown()
+	plan.ClientID = types.StringUnknown()
+	plan.ClientSecret = types.StringUnknown()
+
+	clearPreviousCredential(plan)
+	if rotation.Overlap.IsNull() || rotation.Overlap.IsUnknown() {
+		return diags
+	}
+
+	var overlap ApplicationClientSecretOverlapModel
+	diags.Append(rotation.Overlap.As(ctx, &overlap, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return diags
+	}
+
+	plan.PreviousCredentialID = state.ID
+	if !overlap.Duration.IsNull() {
+		plan.PreviousExpiresAt = types.StringUnknown()
+	}
+	if !overlap.Applies.IsNull() {
+		plan.PreviousRemainingApplies = overlap.Applies
+	}
+
+	return diags
+}
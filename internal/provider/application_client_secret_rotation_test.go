@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPositiveDurationValidator(t *testing.T) {
+	tests := []struct {
+		value     types.String
+		wantError bool
+	}{
+		{types.StringNull(), false},
+		{types.StringUnknown(), false},
+		{types.StringValue("720h"), false},
+		{types.StringValue("1h30m"), false},
+		{types.StringValue("0s"), true},
+		{types.StringValue("-1h"), true},
+		{types.StringValue("30d"), true},
+		{types.StringValue(""), true},
+	}
+
+	for _, tt := range tests {
+		req := validator.StringRequest{Path: path.Root("rotate_after"), ConfigValue: tt.value}
+		resp := &validator.StringResponse{}
+		positiveDurationValidator{}.ValidateString(context.Background(), req, resp)
+
+		if got := resp.Diagnostics.HasError(); got != tt.wantError {
+			t.Errorf("positiveDurationValidator(%s) error = %t, want %t", tt.value, got, tt.wantError)
+		}
+	}
+}
+
+func TestRotationDue(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		createdAt   string
+		rotateAfter string
+		want        bool
+	}{
+		{"2025-06-01T11:00:00Z", "2h", false},
+		{"2025-06-01T10:00:00Z", "2h", true},
+		{"2025-05-01T12:00:00Z", "720h", true},
+		{"2025-05-03T12:00:00Z", "720h", false},
+	}
+
+	for _, tt := range tests {
+		got, err := rotationDue(tt.createdAt, tt.rotateAfter, now)
+		if err != nil {
+			t.Fatalf("rotationDue(%q, %q) returned error: %v", tt.createdAt, tt.rotateAfter, err)
+		}
+		if got != tt.want {
+			t.Errorf("rotationDue(%q, %q) = %t, want %t", tt.createdAt, tt.rotateAfter, got, tt.want)
+		}
+	}
+
+	if _, err := rotationDue("yesterday", "2h", now); err == nil {
+		t.Error("rotationDue with an invalid creation time did not return an error")
+	}
+	if _, err := rotationDue("2025-06-01T11:00:00Z", "2 hours", now); err == nil {
+		t.Error("rotationDue with an invalid rotate_after did not return an error")
+	}
+}
+
+func TestOverlapEnded(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		expiresAt     types.String
+		remaining     types.Int64
+		wantEnded     bool
+		wantRemaining types.Int64
+	}{
+		{"duration running", types.StringValue("2025-06-01T13:00:00Z"), types.Int64Null(), false, types.Int64Null()},
+		{"duration elapsed", types.StringValue("2025-06-01T12:00:00Z"), types.Int64Null(), true, types.Int64Null()},
+		{"applies remaining", types.StringNull(), types.Int64Value(3), false, types.Int64Value(2)},
+		{"last apply", types.StringNull(), types.Int64Value(1), true, types.Int64Null()},
+	}
+
+	for _, tt := range tests {
+		ended, remaining, err := overlapEnded(tt.expiresAt, tt.remaining, now)
+		if err != nil {
+			t.Fatalf("%s: overlapEnded returned error: %v", tt.name, err)
+		}
+		if ended != tt.wantEnded {
+			t.Errorf("%s: overlapEnded ended = %t, want %t", tt.name, ended, tt.wantEnded)
+		}
+		if !remaining.Equal(tt.wantRemaining) {
+			t.Errorf("%s: overlapEnded remaining = %s, want %s", tt.name, remaining, tt.wantRemaining)
+		}
+	}
+
+	if _, _, err := overlapEnded(types.StringValue("tomorrow"), types.Int64Null(), now); err == nil {
+		t.Error("overlapEnded with an invalid previous_expires_at did not return an error")
+	}
+}
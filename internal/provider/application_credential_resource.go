@@ -0,0 +1,1015 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/keycardai/terraform-provider-keycard/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &ApplicationCredentialResource{}
+	_ resource.ResourceWithImportState    = &ApplicationCredentialResource{}
+	_ resource.ResourceWithValidateConfig = &ApplicationCredentialResource{}
+	_ resource.ResourceWithMoveState      = &ApplicationCredentialResource{}
+	_ resource.ResourceWithModifyPlan     = &ApplicationCredentialResource{}
+)
+
+// applicationCredentialTypeAttributes maps each credential type to the nested attribute holding its settings.
+var applicationCredentialTypeAttributes = map[string]string{
+	string(client.ApplicationCredentialTokenTypeToken):         "token",
+	string(client.ApplicationCredentialPasswordTypePassword):   "password",
+	string(client.ApplicationCredentialPublicKeyTypePublicKey): "public_key",
+	string(client.ApplicationCredentialUrlTypeUrl):             "url",
+	string(client.ApplicationCredentialPublicTypePublic):       "public",
+}
+
+func NewApplicationCredentialResource() resource.Resource {
+	return &ApplicationCredentialResource{}
+}
+
+// ApplicationCredentialResource defines the resource implementation.
+type ApplicationCredentialResource struct {
+	client *client.ClientWithResponses
+}
+
+// ApplicationCredentialResourceModel describes the application credential data model.
+type ApplicationCredentialResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	ZoneID         types.String `tfsdk:"zone_id"`
+	Slug           types.String `tfsdk:"slug"`
+	OrganizationID types.String `tfsdk:"organization_id"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+	UpdatedAt      types.String `tfsdk:"updated_at"`
+	ApplicationID  types.String `tfsdk:"application_id"`
+	Type           types.String `tfsdk:"type"`
+	Identifier     types.String `tfsdk:"identifier"`
+	ClientSecret   types.String `tfsdk:"client_secret"`
+	Token          types.Object `tfsdk:"token"`
+	Password       types.Object `tfsdk:"password"`
+	PublicKey      types.Object `tfsdk:"public_key"`
+	URL            types.Object `tfsdk:"url"`
+	Public         types.Object `tfsdk:"public"`
+}
+
+// ApplicationCredentialTokenModel describes the nested token credential data model.
+type ApplicationCredentialTokenModel struct {
+	ProviderID types.String `tfsdk:"provider_id"`
+	Subject    types.String `tfsdk:"subject"`
+}
+
+func (m ApplicationCredentialTokenModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"provider_id": types.StringType,
+		"subject":     types.StringType,
+	}
+}
+
+// ApplicationCredentialPasswordModel describes the nested password credential data model.
+type ApplicationCredentialPasswordModel struct {
+	Identifier types.String `tfsdk:"identifier"`
+}
+
+func (m ApplicationCredentialPasswordModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"identifier": types.StringType,
+	}
+}
+
+// ApplicationCredentialPublicKeyModel describes the nested public key credential data model.
+type ApplicationCredentialPublicKeyModel struct {
+	JwksURI    types.String `tfsdk:"jwks_uri"`
+	Identifier types.String `tfsdk:"identifier"`
+}
+
+func (m ApplicationCredentialPublicKeyModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"jwks_uri":   types.StringType,
+		"identifier": types.StringType,
+	}
+}
+
+// ApplicationCredentialURLModel describes the nested URL credential data model.
+type ApplicationCredentialURLModel struct {
+	URL types.String `tfsdk:"url"`
+}
+
+func (m ApplicationCredentialURLModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"url": types.StringType,
+	}
+}
+
+// ApplicationCredentialPublicModel describes the nested public credential data model.
+type ApplicationCredentialPublicModel struct {
+	Identifier types.String `tfsdk:"identifier"`
+}
+
+func (m ApplicationCredentialPublicModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"identifier": types.StringType,
+	}
+}
+
+func (r *ApplicationCredentialResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application_credential"
+}
+
+func (r *ApplicationCredentialResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a credential of any type for a Keycard application. " +
+			"The `type` attribute selects the kind of credential, and the nested attribute of the same name holds its settings. " +
+			"Existing `keycard_application_client_secret`, `keycard_application_url_credential`, `keycard_application_public_key_credential`, " +
+			"`keycard_application_public_credential` and `keycard_application_workload_identity` resources can be moved to this resource with `moved` blocks.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the credential.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"slug": schema.StringAttribute{
+				MarkdownDescription: "URL-safe identifier of the credential, unique within the zone.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "The organization that owns the credential.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The time the credential was created, in RFC 3339 format.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "The time the credential was last updated, in RFC 3339 format.",
+				Computed:            true,
+			},
+			"zone_id": schema.StringAttribute{
+				MarkdownDescription: "The zone this credential belongs to. Changing this will replace the credential.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"application_id": schema.StringAttribute{
+				MarkdownDescription: "The application this credential belongs to. Changing this will replace the credential.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of credential. Must be one of `token`, `password`, `public-key`, `url` or `public`. " +
+					"The `token`, `public_key` and `url` attributes are required for their respective types, " +
+					"while `password` and `public` are optional. Changing this will replace the credential.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(client.ApplicationCredentialTokenTypeToken),
+						string(client.ApplicationCredentialPasswordTypePassword),
+						string(client.ApplicationCredentialPublicKeyTypePublicKey),
+						string(client.ApplicationCredentialUrlTypeUrl),
+						string(client.ApplicationCredentialPublicTypePublic),
+					),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"identifier": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the credential. This is the OAuth 2.0 client ID for `password`, `public-key` and `public` credentials, " +
+					"the URL for `url` credentials, and the subject, or `*` when no subject is set, for `token` credentials.",
+				Computed: true,
+			},
+			"client_secret": schema.StringAttribute{
+				MarkdownDescription: "The OAuth 2.0 client secret of a `password` credential. This value is only returned on creation and cannot be retrieved later. Store it securely.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"token": schema.SingleNestedAttribute{
+				MarkdownDescription: "Settings of a `token` credential, which authenticates the application with tokens issued by a provider, such as a workload identity.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"provider_id": schema.StringAttribute{
+						MarkdownDescription: "The provider that issues the tokens verified by this credential. Changing this will replace the credential.",
+						Required:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+					"subject": schema.StringAttribute{
+						MarkdownDescription: "The subject claim (sub) that must match in the token. When omitted, any token from the provider is accepted.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+				},
+			},
+			"password": schema.SingleNestedAttribute{
+				MarkdownDescription: "Settings of a `password` credential, a client ID and client secret pair for the OAuth 2.0 client credentials flow. " +
+					"Read from the credential when not configured.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"identifier": schema.StringAttribute{
+						MarkdownDescription: "The OAuth 2.0 client ID for this credential. Auto-generated when not specified. Changing this will replace the credential.",
+						Optional:            true,
+						Computed:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+							stringplanmodifier.RequiresReplaceIf(
+								requiresReplaceIfIdentifierChanged,
+								"Changing the configured identifier of the credential will replace it.",
+								"Changing the configured identifier of the credential will replace it.",
+							),
+						},
+					},
+				},
+			},
+			"public_key": schema.SingleNestedAttribute{
+				MarkdownDescription: "Settings of a `public-key` credential, which authenticates the application with JWT assertions signed by keys published at a JWKS URI.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"jwks_uri": schema.StringAttribute{
+						MarkdownDescription: "The JWKS URI from which the public keys used to verify the application's JWT assertions are retrieved. Changing this will replace the credential.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+					"identifier": schema.StringAttribute{
+						MarkdownDescription: "The OAuth 2.0 client ID for this credential. Auto-generated when not specified. Changing this will replace the credential.",
+						Optional:            true,
+						Computed:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+							stringplanmodifier.RequiresReplaceIfConfigured(),
+						},
+					},
+				},
+			},
+			"url": schema.SingleNestedAttribute{
+				MarkdownDescription: "Settings of a `url` credential, which locates the application's OAuth client ID metadata document.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"url": schema.StringAttribute{
+						MarkdownDescription: "The URL of the client ID metadata document. Must be a valid URL.",
+						Required:            true,
+					},
+				},
+			},
+			"public": schema.SingleNestedAttribute{
+				MarkdownDescription: "Settings of a `public` credential, which registers a public OAuth client identified by a client ID alone. " +
+					"Read from the credential when not configured.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"identifier": schema.StringAttribute{
+						MarkdownDescription: "The OAuth 2.0 client ID for this credential. Auto-generated when not specified. Can be updated in place.",
+						Optional:            true,
+						Computed:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// requiresReplaceIfIdentifierChanged compares a configured identifier with the identifier of the existing
+// credential instead of the nested prior state, which is unset after an import or a move.
+func requiresReplaceIfIdentifierChanged(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var identifier types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("identifier"), &identifier)...)
+	if resp.Diagnostics.HasError() || identifier.IsNull() || identifier.IsUnknown() {
+		return
+	}
+
+	resp.RequiresReplace = !req.ConfigValue.Equal(identifier)
+}
+
+func (r *ApplicationCredentialResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ClientWithResponses)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ApplicationCredentialResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ApplicationCredentialResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Type.IsNull() || data.Type.IsUnknown() {
+		return
+	}
+
+	credType := data.Type.ValueString()
+	blocks := map[string]types.Object{
+		"token":      data.Token,
+		"password":   data.Password,
+		"public_key": data.PublicKey,
+		"url":        data.URL,
+		"public":     data.Public,
+	}
+
+	// Only the settings of the selected type can be set
+	for credentialType, attribute := range applicationCredentialTypeAttributes {
+		if credentialType == credType || blocks[attribute].IsNull() {
+			continue
+		}
+
+		resp.Diagnostics.AddAttributeError(
+			path.Root(attribute),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("%s can only be set for %s credentials, got type %s.", attribute, credentialType, credType),
+		)
+	}
+
+	// Types with required settings must include them
+	switch credType {
+	case string(client.ApplicationCredentialTokenTypeToken),
+		string(client.ApplicationCredentialPublicKeyTypePublicKey),
+		string(client.ApplicationCredentialUrlTypeUrl):
+		attribute := applicationCredentialTypeAttributes[credType]
+		if blocks[attribute].IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attribute),
+				"Missing Attribute Configuration",
+				fmt.Sprintf("%s must be set for %s credentials.", attribute, credType),
+			)
+		}
+	}
+}
+
+func (r *ApplicationCredentialResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan ApplicationCredentialResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Type.IsUnknown() {
+		return
+	}
+
+	// The password and public settings are read from the credential, but only exist for their own type
+	if plan.Password.IsUnknown() && plan.Type.ValueString() != string(client.ApplicationCredentialPasswordTypePassword) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("password"), types.ObjectNull(ApplicationCredentialPasswordModel{}.AttributeTypes()))...)
+	}
+	if plan.Public.IsUnknown() && plan.Type.ValueString() != string(client.ApplicationCredentialPublicTypePublic) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("public"), types.ObjectNull(ApplicationCredentialPublicModel{}.AttributeTypes()))...)
+	}
+}
+
+func (r *ApplicationCredentialResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ApplicationCredentialResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build the create request for the credential type
+	createReq, diags := applicationCredentialCreateRequest(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the credential
+	createResp, err := r.client.CreateApplicationCredentialWithResponse(ctx, data.ZoneID.ValueString(), *createReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create application credential, got error: %s", err))
+		return
+	}
+
+	if createResp.StatusCode() != 200 {
+		resp.Diagnostics.AddError(
+			"API Error",
+			fmt.Sprintf("Unable to create application credential, got status %d: %s", createResp.StatusCode(), string(createResp.Body)),
+		)
+		return
+	}
+
+	if createResp.JSON200 == nil {
+		resp.Diagnostics.AddError("API Error", "Unable to create application credential, no response body")
+		return
+	}
+
+	// The create response holds the same members as the credential union
+	var cred client.ApplicationCredential
+	raw, err := createResp.JSON200.MarshalJSON()
+	if err == nil {
+		err = cred.UnmarshalJSON(raw)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to parse application credential response, got error: %s", err))
+		return
+	}
+
+	// Update the model with the response data
+	resp.Diagnostics.Append(updateApplicationCredentialResourceModelFromAPIResponse(ctx, &cred, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ApplicationCredentialResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ApplicationCredentialResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get the credential
+	getResp, err := r.client.GetApplicationCredentialWithResponse(ctx, data.ZoneID.ValueString(), data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read application credential, got error: %s", err))
+		return
+	}
+
+	if getResp.StatusCode() == 404 {
+		// Credential was deleted outside of Terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if getResp.StatusCode() != 200 {
+		resp.Diagnostics.AddError(
+			"API Error",
+			fmt.Sprintf("Unable to read application credential, got status %d: %s", getResp.StatusCode(), string(getResp.Body)),
+		)
+		return
+	}
+
+	if getResp.JSON200 == nil {
+		resp.Diagnostics.AddError("API Error", "Unable to read application credential, no response body")
+		return
+	}
+
+	// Update the model with the response data
+	resp.Diagnostics.Append(updateApplicationCredentialResourceModelFromAPIResponse(ctx, getResp.JSON200, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ApplicationCredentialResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ApplicationCredentialResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build the update request for the credential type
+	updateReq, diags := applicationCredentialUpdateRequest(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update the credential
+	updateResp, err := r.client.UpdateApplicationCredentialWithResponse(ctx, data.ZoneID.ValueString(), data.ID.ValueString(), *updateReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update application credential, got error: %s", err))
+		return
+	}
+
+	if updateResp.StatusCode() != 200 {
+		resp.Diagnostics.AddError(
+			"API Error",
+			fmt.Sprintf("Unable to update application credential, got status %d: %s", updateResp.StatusCode(), string(updateResp.Body)),
+		)
+		return
+	}
+
+	if updateResp.JSON200 == nil {
+		resp.Diagnostics.AddError("API Error", "Unable to update application credential, no response body")
+		return
+	}
+
+	// Update the model with the response data
+	resp.Diagnostics.Append(updateApplicationCredentialResourceModelFromAPIResponse(ctx, updateResp.JSON200, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ApplicationCredentialResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ApplicationCredentialResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete the credential
+	deleteResp, err := r.client.DeleteApplicationCredentialWithResponse(ctx, data.ZoneID.ValueString(), data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete application credential, got error: %s", err))
+		return
+	}
+
+	if deleteResp.StatusCode() != 204 && deleteResp.StatusCode() != 404 {
+		resp.Diagnostics.AddError(
+			"API Error",
+			fmt.Sprintf("Unable to delete application credential, got status %d: %s", deleteResp.StatusCode(), string(deleteResp.Body)),
+		)
+		return
+	}
+}
+
+func (r *ApplicationCredentialResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Parse import ID as zones/{zone-id}/application-credentials/{credential-id}
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 4 || parts[0] != "zones" || parts[2] != "application-credentials" || parts[1] == "" || parts[3] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID in format 'zones/{zone-id}/application-credentials/{credential-id}', got: %s", req.ID),
+		)
+		return
+	}
+
+	zoneID := parts[1]
+	credentialID := parts[3]
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone_id"), zoneID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), credentialID)...)
+}
+
+func (r *ApplicationCredentialResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			SourceSchema: sourceResourceSchema(ctx, NewApplicationClientSecretResource),
+			StateMover:   moveApplicationClientSecretState,
+		},
+		{
+			SourceSchema: sourceResourceSchema(ctx, NewApplicationURLCredentialResource),
+			StateMover:   moveApplicationURLCredentialState,
+		},
+		{
+			SourceSchema: sourceResourceSchema(ctx, NewApplicationPublicKeyCredentialResource),
+			StateMover:   moveApplicationPublicKeyCredentialState,
+		},
+		{
+			SourceSchema: sourceResourceSchema(ctx, NewApplicationPublicCredentialResource),
+			StateMover:   moveApplicationPublicCredentialState,
+		},
+		{
+			SourceSchema: sourceResourceSchema(ctx, NewApplicationWorkloadIdentityResource),
+			StateMover:   moveApplicationWorkloadIdentityState,
+		},
+	}
+}
+
+// sourceResourceSchema returns the schema of a resource of this provider that state can be moved from.
+func sourceResourceSchema(ctx context.Context, newResource func() resource.Resource) *schema.Schema {
+	var resp resource.SchemaResponse
+	newResource().Schema(ctx, resource.SchemaRequest{}, &resp)
+	return &resp.Schema
+}
+
+// isMoveFromKeycardResource reports whether a move request originates from the given resource type of this provider.
+func isMoveFromKeycardResource(req resource.MoveStateRequest, typeName string) bool {
+	return req.SourceState != nil &&
+		req.SourceTypeName == typeName &&
+		strings.HasSuffix(req.SourceProviderAddress, "keycardai/keycard")
+}
+
+// newMovedApplicationCredentialResourceModel returns a model of the given type with the settings of all types unset.
+func newMovedApplicationCredentialResourceModel(credType string) ApplicationCredentialResourceModel {
+	return ApplicationCredentialResourceModel{
+		Type:         types.StringValue(credType),
+		ClientSecret: types.StringNull(),
+		Token:        types.ObjectNull(ApplicationCredentialTokenModel{}.AttributeTypes()),
+		Password:     types.ObjectNull(ApplicationCredentialPasswordModel{}.AttributeTypes()),
+		PublicKey:    types.ObjectNull(ApplicationCredentialPublicKeyModel{}.AttributeTypes()),
+		URL:          types.ObjectNull(ApplicationCredentialURLModel{}.AttributeTypes()),
+		Public:       types.ObjectNull(ApplicationCredentialPublicModel{}.AttributeTypes()),
+	}
+}
+
+func moveApplicationClientSecretState(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+	if !isMoveFromKeycardResource(req, "keycard_application_client_secret") {
+		return
+	}
+
+	var source ApplicationClientSecretModel
+	resp.Diagnostics.Append(req.SourceState.Get(ctx, &source)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The previous credential of a rotation overlap has no place in the credential resource
+	if !source.PreviousCredentialID.IsNull() {
+		resp.Diagnostics.AddError(
+			"Unable to Move Client Secret",
+			fmt.Sprintf("The client secret still keeps the previous credential %s for its rotation overlap. "+
+				"Apply the keycard_application_client_secret resource until the overlap ends before moving it.", source.PreviousCredentialID.ValueString()),
+		)
+		return
+	}
+
+	data := newMovedApplicationCredentialResourceModel(string(client.ApplicationCredentialPasswordTypePassword))
+	data.ID = source.ID
+	data.ZoneID = source.ZoneID
+	data.Slug = source.Slug
+	data.OrganizationID = source.OrganizationID
+	data.CreatedAt = source.CreatedAt
+	data.UpdatedAt = source.UpdatedAt
+	data.ApplicationID = source.ApplicationID
+	data.Identifier = source.ClientID
+	data.ClientSecret = source.ClientSecret
+
+	passwordObj, diags := types.ObjectValueFrom(ctx, ApplicationCredentialPasswordModel{}.AttributeTypes(), ApplicationCredentialPasswordModel{
+		Identifier: source.ClientID,
+	})
+	resp.Diagnostics.Append(diags...)
+	data.Password = passwordObj
+
+	resp.Diagnostics.Append(resp.TargetState.Set(ctx, &data)...)
+}
+
+func moveApplicationURLCredentialState(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+	if !isMoveFromKeycardResource(req, "keycard_application_url_credential") {
+		return
+	}
+
+	var source ApplicationURLCredentialModel
+	resp.Diagnostics.Append(req.SourceState.Get(ctx, &source)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data := newMovedApplicationCredentialResourceModel(string(client.ApplicationCredentialUrlTypeUrl))
+	data.ID = source.ID
+	data.ZoneID = source.ZoneID
+	data.Slug = source.Slug
+	data.OrganizationID = source.OrganizationID
+	data.CreatedAt = source.CreatedAt
+	data.UpdatedAt = source.UpdatedAt
+	data.ApplicationID = source.ApplicationID
+	data.Identifier = source.URL
+
+	urlObj, diags := types.ObjectValueFrom(ctx, ApplicationCredentialURLModel{}.AttributeTypes(), ApplicationCredentialURLModel{
+		URL: source.URL,
+	})
+	resp.Diagnostics.Append(diags...)
+	data.URL = urlObj
+
+	resp.Diagnostics.Append(resp.TargetState.Set(ctx, &data)...)
+}
+
+func moveApplicationPublicKeyCredentialState(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+	if !isMoveFromKeycardResource(req, "keycard_application_public_key_credential") {
+		return
+	}
+
+	var source ApplicationPublicKeyCredentialModel
+	resp.Diagnostics.Append(req.SourceState.Get(ctx, &source)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data := newMovedApplicationCredentialResourceModel(string(client.ApplicationCredentialPublicKeyTypePublicKey))
+	data.ID = source.ID
+	data.ZoneID = source.ZoneID
+	data.Slug = source.Slug
+	data.OrganizationID = source.OrganizationID
+	data.CreatedAt = source.CreatedAt
+	data.UpdatedAt = source.UpdatedAt
+	data.ApplicationID = source.ApplicationID
+	data.Identifier = source.Identifier
+
+	publicKeyObj, diags := types.ObjectValueFrom(ctx, ApplicationCredentialPublicKeyModel{}.AttributeTypes(), ApplicationCredentialPublicKeyModel{
+		JwksURI:    source.JwksURI,
+		Identifier: source.Identifier,
+	})
+	resp.Diagnostics.Append(diags...)
+	data.PublicKey = publicKeyObj
+
+	resp.Diagnostics.Append(resp.TargetState.Set(ctx, &data)...)
+}
+
+func moveApplicationPublicCredentialState(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+	if !isMoveFromKeycardResource(req, "keycard_application_public_credential") {
+		return
+	}
+
+	var source ApplicationPublicCredentialModel
+	resp.Diagnostics.Append(req.SourceState.Get(ctx, &source)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data := newMovedApplicationCredentialResourceModel(string(client.ApplicationCredentialPublicTypePublic))
+	data.ID = source.ID
+	data.ZoneID = source.ZoneID
+	data.Slug = source.Slug
+	data.OrganizationID = source.OrganizationID
+	data.CreatedAt = source.CreatedAt
+	data.UpdatedAt = source.UpdatedAt
+	data.ApplicationID = source.ApplicationID
+	data.Identifier = source.Identifier
+
+	publicObj, diags := types.ObjectValueFrom(ctx, ApplicationCredentialPublicModel{}.AttributeTypes(), ApplicationCredentialPublicModel{
+		Identifier: source.Identifier,
+	})
+	resp.Diagnostics.Append(diags...)
+	data.Public = publicObj
+
+	resp.Diagnostics.Append(resp.TargetState.Set(ctx, &data)...)
+}
+
+func moveApplicationWorkloadIdentityState(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+	if !isMoveFromKeycardResource(req, "keycard_application_workload_identity") {
+		return
+	}
+
+	var source ApplicationWorkloadIdentityModel
+	resp.Diagnostics.Append(req.SourceState.Get(ctx, &source)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data := newMovedApplicationCredentialResourceModel(string(client.ApplicationCredentialTokenTypeToken))
+	data.ID = source.ID
+	data.ZoneID = source.ZoneID
+	data.Slug = source.Slug
+	data.OrganizationID = source.OrganizationID
+	data.CreatedAt = source.CreatedAt
+	data.UpdatedAt = source.UpdatedAt
+	data.ApplicationID = source.ApplicationID
+
+	// The identifier is not part of the workload identity state, it is refreshed on the next read
+	data.Identifier = types.StringValue("*")
+	if !source.Subject.IsNull() {
+		data.Identifier = source.Subject
+	}
+
+	tokenObj, diags := types.ObjectValueFrom(ctx, ApplicationCredentialTokenModel{}.AttributeTypes(), ApplicationCredentialTokenModel{
+		ProviderID: source.ProviderID,
+		Subject:    source.Subject,
+	})
+	resp.Diagnostics.Append(diags...)
+	data.Token = tokenObj
+
+	resp.Diagnostics.Append(resp.TargetState.Set(ctx, &data)...)
+}
+
+// optionalStringPointer returns a pointer to the value of an optional attribute, or nil when it is not set or not yet known.
+func optionalStringPointer(val types.String) *string {
+	if val.IsNull() || val.IsUnknown() {
+		return nil
+	}
+
+	return val.ValueStringPointer()
+}
+
+// applicationCredentialCreateRequest builds the create request for the credential type of the plan.
+func applicationCredentialCreateRequest(ctx context.Context, data *ApplicationCredentialResourceModel) (*client.ApplicationCredentialCreate, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var err error
+
+	createReq := client.ApplicationCredentialCreate{}
+	applicationID := data.ApplicationID.ValueString()
+
+	switch data.Type.ValueString() {
+	case string(client.ApplicationCredentialTokenTypeToken):
+		var token ApplicationCredentialTokenModel
+		diags.Append(data.Token.As(ctx, &token, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		err = createReq.FromApplicationCredentialCreateToken(client.ApplicationCredentialCreateToken{
+			ApplicationId: applicationID,
+			Type:          client.ApplicationCredentialCreateTokenTypeToken,
+			ProviderId:    token.ProviderID.ValueString(),
+			Subject:       optionalStringPointer(token.Subject),
+		})
+	case string(client.ApplicationCredentialPasswordTypePassword):
+		var password ApplicationCredentialPasswordModel
+		if !data.Password.IsNull() && !data.Password.IsUnknown() {
+			diags.Append(data.Password.As(ctx, &password, basetypes.ObjectAsOptions{})...)
+			if diags.HasError() {
+				return nil, diags
+			}
+		}
+
+		err = createReq.FromApplicationCredentialCreatePassword(client.ApplicationCredentialCreatePassword{
+			ApplicationId: applicationID,
+			Type:          client.ApplicationCredentialCreatePasswordTypePassword,
+			Identifier:    optionalStringPointer(password.Identifier),
+		})
+	case string(client.ApplicationCredentialPublicKeyTypePublicKey):
+		var publicKey ApplicationCredentialPublicKeyModel
+		diags.Append(data.PublicKey.As(ctx, &publicKey, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		err = createReq.FromApplicationCredentialCreatePublicKey(client.ApplicationCredentialCreatePublicKey{
+			ApplicationId: applicationID,
+			Type:          client.ApplicationCredentialCreatePublicKeyTypePublicKey,
+			JwksUri:       publicKey.JwksURI.ValueString(),
+			Identifier:    optionalStringPointer(publicKey.Identifier),
+		})
+	case string(client.ApplicationCredentialUrlTypeUrl):
+		var url ApplicationCredentialURLModel
+		diags.Append(data.URL.As(ctx, &url, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		err = createReq.FromApplicationCredentialCreateUrl(client.ApplicationCredentialCreateUrl{
+			ApplicationId: applicationID,
+			Type:          client.ApplicationCredentialCreateUrlTypeUrl,
+			Identifier:    url.URL.ValueString(),
+		})
+	case string(client.ApplicationCredentialPublicTypePublic):
+		var public ApplicationCredentialPublicModel
+		if !data.Public.IsNull() && !data.Public.IsUnknown() {
+			diags.Append(data.Public.As(ctx, &public, basetypes.ObjectAsOptions{})...)
+			if diags.HasError() {
+				return nil, diags
+			}
+		}
+
+		err = createReq.FromApplicationCredentialCreatePublic(client.ApplicationCredentialCreatePublic{
+			ApplicationId: applicationID,
+			Type:          client.ApplicationCredentialCreatePublicTypePublic,
+			Identifier:    optionalStringPointer(public.Identifier),
+		})
+	default:
+		diags.AddError("Client Error", fmt.Sprintf("Unsupported application credential type %q", data.Type.ValueString()))
+		return nil, diags
+	}
+
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to construct application credential request body, got error: %s", err))
+		return nil, diags
+	}
+
+	return &createReq, diags
+}
+
+// applicationCredentialUpdateRequest builds the update request for the credential type of the plan.
+// Settings that cannot be updated in place are not included, changing them replaces the credential.
+func applicationCredentialUpdateRequest(ctx context.Context, data *ApplicationCredentialResourceModel) (*client.ApplicationCredentialUpdate, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var err error
+
+	updateReq := client.ApplicationCredentialUpdate{}
+
+	switch data.Type.ValueString() {
+	case string(client.ApplicationCredentialTokenTypeToken):
+		var token ApplicationCredentialTokenModel
+		diags.Append(data.Token.As(ctx, &token, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		tokenUpdateType := client.Token
+		err = updateReq.FromTokenCredentialUpdate(client.TokenCredentialUpdate{
+			Type:    &tokenUpdateType,
+			Subject: StringValueNullable(token.Subject),
+		})
+	case string(client.ApplicationCredentialPasswordTypePassword):
+		passwordUpdateType := client.Password
+		err = updateReq.FromPasswordCredentialUpdate(client.PasswordCredentialUpdate{
+			Type: &passwordUpdateType,
+		})
+	case string(client.ApplicationCredentialPublicKeyTypePublicKey):
+		publicKeyUpdateType := client.PublicKey
+		err = updateReq.FromPublicKeyCredentialUpdate(client.PublicKeyCredentialUpdate{
+			Type: &publicKeyUpdateType,
+		})
+	case string(client.ApplicationCredentialUrlTypeUrl):
+		var url ApplicationCredentialURLModel
+		diags.Append(data.URL.As(ctx, &url, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		urlUpdateType := client.Url
+		err = updateReq.FromUrlCredentialUpdate(client.UrlCredentialUpdate{
+			Type:       &urlUpdateType,
+			Identifier: url.URL.ValueStringPointer(),
+		})
+	case string(client.ApplicationCredentialPublicTypePublic):
+		var public ApplicationCredentialPublicModel
+		if !data.Public.IsNull() && !data.Public.IsUnknown() {
+			diags.Append(data.Public.As(ctx, &public, basetypes.ObjectAsOptions{})...)
+			if diags.HasError() {
+				return nil, diags
+			}
+		}
+
+		publicUpdateType := client.Public
+		err = updateReq.FromPublicCredentialUpdate(client.PublicCredentialUpdate{
+			Type:       &publicUpdateType,
+			Identifier: optionalStringPointer(public.Identifier),
+		})
+	default:
+		diags.AddError("Client Error", fmt.Sprintf("Unsupported application credential type %q", data.Type.ValueString()))
+		return nil, diags
+	}
+
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to construct application credential update request body, got error: %s", err))
+		return nil, diags
+	}
+
+	return &updateReq, diags
+}
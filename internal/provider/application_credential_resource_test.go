@@ -0,0 +1,535 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func testAccApplicationCredentialImportStateIdFunc(s *terraform.State) (string, error) {
+	rs, ok := s.RootModule().Resources["keycard_application_credential.test"]
+	if !ok {
+		return "", fmt.Errorf("Not found: keycard_application_credential.test")
+	}
+	zoneID := rs.Primary.Attributes["zone_id"]
+	id := rs.Primary.ID
+	return fmt.Sprintf("zones/%s/application-credentials/%s", zoneID, id), nil
+}
+
+func TestAccApplicationCredentialResource_token(t *testing.T) {
+	zoneName := acctest.RandomWithPrefix("tftest-zone")
+	appName := acctest.RandomWithPrefix("tftest-app")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with a subject
+			{
+				Config: testAccApplicationCredentialResourceConfig_token(zoneName, appName, `subject = "system:serviceaccount:default:app"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("keycard_application_credential.test", "id"),
+					resource.TestCheckResourceAttrSet("keycard_application_credential.test", "slug"),
+					resource.TestCheckResourceAttrSet("keycard_application_credential.test", "organization_id"),
+					resource.TestCheckResourceAttrSet("keycard_application_credential.test", "created_at"),
+					resource.TestCheckResourceAttrSet("keycard_application_credential.test", "updated_at"),
+					resource.TestCheckResourceAttr("keycard_application_credential.test", "type", "token"),
+					resource.TestCheckResourceAttr("keycard_application_credential.test", "identifier", "system:serviceaccount:default:app"),
+					resource.TestCheckResourceAttr("keycard_application_credential.test", "token.subject", "system:serviceaccount:default:app"),
+					resource.TestCheckResourceAttrPair(
+						"keycard_application_credential.test", "token.provider_id",
+						"keycard_provider.test", "id",
+					),
+					resource.TestCheckNoResourceAttr("keycard_application_credential.test", "client_secret"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "keycard_application_credential.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccApplicationCredentialImportStateIdFunc,
+			},
+			// Remove the subject in place
+			{
+				Config: testAccApplicationCredentialResourceConfig_token(zoneName, appName, ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("keycard_application_credential.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("keycard_application_credential.test", "identifier", "*"),
+					resource.TestCheckNoResourceAttr("keycard_application_credential.test", "token.subject"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccApplicationCredentialResource_password(t *testing.T) {
+	zoneName := acctest.RandomWithPrefix("tftest-zone")
+	appName := acctest.RandomWithPrefix("tftest-app")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create without settings
+			{
+				Config: testAccApplicationCredentialResourceConfig_password(zoneName, appName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("keycard_application_credential.test", "id"),
+					resource.TestCheckResourceAttr("keycard_application_credential.test", "type", "password"),
+					resource.TestCheckResourceAttrSet("keycard_application_credential.test", "identifier"),
+					resource.TestCheckResourceAttrSet("keycard_application_credential.test", "client_secret"),
+					resource.TestCheckResourceAttrPair(
+						"keycard_application_credential.test", "password.identifier",
+						"keycard_application_credential.test", "identifier",
+					),
+				),
+			},
+			// ImportState testing, the client_secret cannot be retrieved after creation
+			{
+				ResourceName:            "keycard_application_credential.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       testAccApplicationCredentialImportStateIdFunc,
+				ImportStateVerifyIgnore: []string{"client_secret"},
+			},
+		},
+	})
+}
+
+func TestAccApplicationCredentialResource_passwordIdentifierImport(t *testing.T) {
+	zoneName := acctest.RandomWithPrefix("tftest-zone")
+	appName := acctest.RandomWithPrefix("tftest-app")
+	identifier := acctest.RandomWithPrefix("tftest-client")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			// Create with an identifier
+			{
+				Config: testAccApplicationCredentialResourceConfig_passwordWithIdentifier(zoneName, appName, identifier),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("keycard_application_credential.test", "password.identifier", identifier),
+					resource.TestCheckResourceAttr("keycard_application_credential.test", "identifier", identifier),
+				),
+			},
+			// Importing with the identifier configured neither replaces nor updates the credential
+			{
+				ResourceName:      "keycard_application_credential.test",
+				ImportState:       true,
+				ImportStateKind:   resource.ImportBlockWithID,
+				ImportStateIdFunc: testAccApplicationCredentialImportStateIdFunc,
+				ImportPlanChecks: resource.ImportPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccApplicationCredentialResource_publicKey(t *testing.T) {
+	zoneName := acctest.RandomWithPrefix("tftest-zone")
+	appName := acctest.RandomWithPrefix("tftest-app")
+	jwksURI1 := fmt.Sprintf("https://%s.example.com/.well-known/jwks.json", appName)
+	jwksURI2 := fmt.Sprintf("https://%s.example.com/jwks.json", appName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with a JWKS URI
+			{
+				Config: testAccApplicationCredentialResourceConfig_publicKey(zoneName, appName, jwksURI1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("keycard_application_credential.test", "type", "public-key"),
+					resource.TestCheckResourceAttr("keycard_application_credential.test", "public_key.jwks_uri", jwksURI1),
+					resource.TestCheckResourceAttrSet("keycard_application_credential.test", "public_key.identifier"),
+					resource.TestCheckResourceAttrPair(
+						"keycard_application_credential.test", "identifier",
+						"keycard_application_credential.test", "public_key.identifier",
+					),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "keycard_application_credential.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccApplicationCredentialImportStateIdFunc,
+			},
+			// Change the JWKS URI (should force replacement)
+			{
+				Config: testAccApplicationCredentialResourceConfig_publicKey(zoneName, appName, jwksURI2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("keycard_application_credential.test", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("keycard_application_credential.test", "public_key.jwks_uri", jwksURI2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccApplicationCredentialResource_url(t *testing.T) {
+	zoneName := acctest.RandomWithPrefix("tftest-zone")
+	appName := acctest.RandomWithPrefix("tftest-app")
+	url1 := fmt.Sprintf("https://%s-1.example.com/client-metadata.json", appName)
+	url2 := fmt.Sprintf("https://%s-2.example.com/client-metadata.json", appName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with the first URL
+			{
+				Config: testAccApplicationCredentialResourceConfig_url(zoneName, appName, url1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("keycard_application_credential.test", "type", "url"),
+					resource.TestCheckResourceAttr("keycard_application_credential.test", "url.url", url1),
+					resource.TestCheckResourceAttr("keycard_application_credential.test", "identifier", url1),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "keycard_application_credential.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccApplicationCredentialImportStateIdFunc,
+			},
+			// Change the URL in place
+			{
+				Config: testAccApplicationCredentialResourceConfig_url(zoneName, appName, url2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("keycard_application_credential.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("keycard_application_credential.test", "url.url", url2),
+					resource.TestCheckResourceAttr("keycard_application_credential.test", "identifier", url2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccApplicationCredentialResource_public(t *testing.T) {
+	zoneName := acctest.RandomWithPrefix("tftest-zone")
+	appName := acctest.RandomWithPrefix("tftest-app")
+	identifier1 := acctest.RandomWithPrefix("tftest-client")
+	identifier2 := acctest.RandomWithPrefix("tftest-client")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			// Create with an identifier
+			{
+				Config: testAccApplicationCredentialResourceConfig_public(zoneName, appName, identifier1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("keycard_application_credential.test", "type", "public"),
+					resource.TestCheckResourceAttr("keycard_application_credential.test", "public.identifier", identifier1),
+					resource.TestCheckResourceAttr("keycard_application_credential.test", "identifier", identifier1),
+				),
+			},
+			// Change the identifier in place
+			{
+				Config: testAccApplicationCredentialResourceConfig_public(zoneName, appName, identifier2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("keycard_application_credential.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("keycard_application_credential.test", "public.identifier", identifier2),
+					resource.TestCheckResourceAttr("keycard_application_credential.test", "identifier", identifier2),
+				),
+			},
+			// Importing with the identifier configured does not update the credential
+			{
+				ResourceName:      "keycard_application_credential.test",
+				ImportState:       true,
+				ImportStateKind:   resource.ImportBlockWithID,
+				ImportStateIdFunc: testAccApplicationCredentialImportStateIdFunc,
+				ImportPlanChecks: resource.ImportPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccApplicationCredentialResource_invalidConfig(t *testing.T) {
+	zoneName := acctest.RandomWithPrefix("tftest-zone")
+	appName := acctest.RandomWithPrefix("tftest-app")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Settings of the selected type are missing
+			{
+				Config:      testAccApplicationCredentialResourceConfig_custom(zoneName, appName, `type = "url"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Missing Attribute Configuration"),
+			},
+			// Settings of another type are set
+			{
+				Config: testAccApplicationCredentialResourceConfig_custom(zoneName, appName, `type = "password"
+
+  url = {
+    url = "https://example.com/client-metadata.json"
+  }`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			// Unknown credential type
+			{
+				Config:      testAccApplicationCredentialResourceConfig_custom(zoneName, appName, `type = "certificate"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value Match`),
+			},
+		},
+	})
+}
+
+func TestAccApplicationCredentialResource_moveFromURLCredential(t *testing.T) {
+	zoneName := acctest.RandomWithPrefix("tftest-zone")
+	appName := acctest.RandomWithPrefix("tftest-app")
+	url := fmt.Sprintf("https://%s.example.com/client-metadata.json", appName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			// Create with the URL credential resource
+			{
+				Config: testAccApplicationURLCredentialResourceConfig_basic(zoneName, appName, url),
+			},
+			// Move to the generic credential resource without changes
+			{
+				Config: testAccApplicationCredentialResourceConfig_movedURL(zoneName, appName, url),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("keycard_application_credential.test", plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("keycard_application_credential.test", "type", "url"),
+					resource.TestCheckResourceAttr("keycard_application_credential.test", "url.url", url),
+				),
+			},
+		},
+	})
+}
+
+func TestAccApplicationCredentialResource_moveFromClientSecret(t *testing.T) {
+	zoneName := acctest.RandomWithPrefix("tftest-zone")
+	appName := acctest.RandomWithPrefix("tftest-app")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			// Create with the client secret resource
+			{
+				Config: testAccApplicationClientSecretResourceConfig_basic(zoneName, appName),
+			},
+			// Move to the generic credential resource, keeping the client secret
+			{
+				Config: testAccApplicationCredentialResourceConfig_movedClientSecret(zoneName, appName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("keycard_application_credential.test", plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("keycard_application_credential.test", "type", "password"),
+					resource.TestCheckResourceAttrSet("keycard_application_credential.test", "identifier"),
+					resource.TestCheckResourceAttrSet("keycard_application_credential.test", "client_secret"),
+					resource.TestCheckResourceAttrPair(
+						"keycard_application_credential.test", "password.identifier",
+						"keycard_application_credential.test", "identifier",
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestAccApplicationCredentialResource_moveFromPublicCredential(t *testing.T) {
+	zoneName := acctest.RandomWithPrefix("tftest-zone")
+	appName := acctest.RandomWithPrefix("tftest-app")
+	identifier := acctest.RandomWithPrefix("tftest-client")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			// Create with the public credential resource
+			{
+				Config: testAccApplicationPublicCredentialResourceConfig_withIdentifier(zoneName, appName, identifier),
+			},
+			// Move to the generic credential resource with the identifier configured, without changes
+			{
+				Config: testAccApplicationCredentialResourceConfig_movedPublic(zoneName, appName, identifier),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("keycard_application_credential.test", "type", "public"),
+					resource.TestCheckResourceAttr("keycard_application_credential.test", "public.identifier", identifier),
+				),
+			},
+		},
+	})
+}
+
+func testAccApplicationCredentialResourceConfig_custom(zoneName, appName, credential string) string {
+	return fmt.Sprintf(`
+resource "keycard_zone" "test" {
+  name = %[1]q
+}
+
+resource "keycard_application" "test" {
+  name       = %[2]q
+  identifier = "https://%[2]s.example.com"
+  zone_id    = keycard_zone.test.id
+}
+
+resource "keycard_application_credential" "test" {
+  zone_id        = keycard_zone.test.id
+  application_id = keycard_application.test.id
+  %[3]s
+}
+`, zoneName, appName, credential)
+}
+
+func testAccApplicationCredentialResourceConfig_token(zoneName, appName, subject string) string {
+	return fmt.Sprintf(`
+resource "keycard_zone" "test" {
+  name = %[1]q
+}
+
+resource "keycard_provider" "test" {
+  name       = "k8s-provider-%[2]s"
+  identifier = "https://kubernetes.default.svc.cluster.local"
+  zone_id    = keycard_zone.test.id
+}
+
+resource "keycard_application" "test" {
+  name       = %[2]q
+  identifier = "https://%[2]s.example.com"
+  zone_id    = keycard_zone.test.id
+}
+
+resource "keycard_application_credential" "test" {
+  zone_id        = keycard_zone.test.id
+  application_id = keycard_application.test.id
+  type           = "token"
+
+  token = {
+    provider_id = keycard_provider.test.id
+    %[3]s
+  }
+}
+`, zoneName, appName, subject)
+}
+
+func testAccApplicationCredentialResourceConfig_password(zoneName, appName string) string {
+	return testAccApplicationCredentialResourceConfig_custom(zoneName, appName, `type = "password"`)
+}
+
+func testAccApplicationCredentialResourceConfig_passwordWithIdentifier(zoneName, appName, identifier string) string {
+	return testAccApplicationCredentialResourceConfig_custom(zoneName, appName, fmt.Sprintf(`type = "password"
+
+  password = {
+    identifier = %[1]q
+  }`, identifier))
+}
+
+func testAccApplicationCredentialResourceConfig_publicKey(zoneName, appName, jwksURI string) string {
+	return testAccApplicationCredentialResourceConfig_custom(zoneName, appName, fmt.Sprintf(`type = "public-key"
+
+  public_key = {
+    jwks_uri = %[1]q
+  }`, jwksURI))
+}
+
+func testAccApplicationCredentialResourceConfig_url(zoneName, appName, url string) string {
+	return testAccApplicationCredentialResourceConfig_custom(zoneName, appName, fmt.Sprintf(`type = "url"
+
+  url = {
+    url = %[1]q
+  }`, url))
+}
+
+func testAccApplicationCredentialResourceConfig_public(zoneName, appName, identifier string) string {
+	return testAccApplicationCredentialResourceConfig_custom(zoneName, appName, fmt.Sprintf(`type = "public"
+
+  public = {
+    identifier = %[1]q
+  }`, identifier))
+}
+
+func testAccApplicationCredentialResourceConfig_movedURL(zoneName, appName, url string) string {
+	return testAccApplicationCredentialResourceConfig_url(zoneName, appName, url) + `
+moved {
+  from = keycard_application_url_credential.test
+  to   = keycard_application_credential.test
+}
+`
+}
+
+func testAccApplicationCredentialResourceConfig_movedClientSecret(zoneName, appName string) string {
+	return testAccApplicationCredentialResourceConfig_password(zoneName, appName) + `
+moved {
+  from = keycard_application_client_secret.test
+  to   = keycard_application_credential.test
+}
+`
+}
+
+func testAccApplicationCredentialResourceConfig_movedPublic(zoneName, appName, identifier string) string {
+	return testAccApplicationCredentialResourceConfig_public(zoneName, appName, identifier) + `
+moved {
+  from = keycard_application_public_credential.test
+  to   = keycard_application_credential.test
+}
+`
+}
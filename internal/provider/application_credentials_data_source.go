@@ -0,0 +1,283 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/keycardai/terraform-provider-keycard/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ApplicationCredentialsDataSource{}
+
+func NewApplicationCredentialsDataSource() datasource.DataSource {
+	return &ApplicationCredentialsDataSource{}
+}
+
+// ApplicationCredentialsDataSource defines the data source implementation.
+type ApplicationCredentialsDataSource struct {
+	client *client.ClientWithResponses
+}
+
+// ApplicationCredentialsDataSourceModel describes the data source data model.
+type ApplicationCredentialsDataSourceModel struct {
+	ZoneID        types.String                        `tfsdk:"zone_id"`
+	ApplicationID types.String                        `tfsdk:"application_id"`
+	Type          types.String                        `tfsdk:"type"`
+	Credentials   []ApplicationCredentialSummaryModel `tfsdk:"credentials"`
+}
+
+// ApplicationCredentialSummaryModel describes a credential of any type, without its secret.
+type ApplicationCredentialSummaryModel struct {
+	ID             types.String `tfsdk:"id"`
+	ZoneID         types.String `tfsdk:"zone_id"`
+	Slug           types.String `tfsdk:"slug"`
+	OrganizationID types.String `tfsdk:"organization_id"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+	UpdatedAt      types.String `tfsdk:"updated_at"`
+	ApplicationID  types.String `tfsdk:"application_id"`
+	Type           types.String `tfsdk:"type"`
+	Identifier     types.String `tfsdk:"identifier"`
+	ProviderID     types.String `tfsdk:"provider_id"`
+	Subject        types.String `tfsdk:"subject"`
+	JwksURI        types.String `tfsdk:"jwks_uri"`
+}
+
+func (d *ApplicationCredentialsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application_credentials"
+}
+
+func (d *ApplicationCredentialsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the credentials of Keycard applications in a zone, across all credential types. " +
+			"Secrets such as client secrets are never included.",
+
+		Attributes: map[string]schema.Attribute{
+			"zone_id": schema.StringAttribute{
+				MarkdownDescription: "The zone to list credentials from.",
+				Required:            true,
+			},
+			"application_id": schema.StringAttribute{
+				MarkdownDescription: "Only return the credentials of this application. When omitted, the credentials of every application in the zone are returned.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Only return credentials of this type. One of `password`, `token`, `public-key`, `url` or `public`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(client.ApplicationCredentialPasswordTypePassword),
+						string(client.ApplicationCredentialTokenTypeToken),
+						string(client.ApplicationCredentialPublicKeyTypePublicKey),
+						string(client.ApplicationCredentialUrlTypeUrl),
+						string(client.ApplicationCredentialPublicTypePublic),
+					),
+				},
+			},
+			"credentials": schema.ListNestedAttribute{
+				MarkdownDescription: "The credentials matching the filters.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Unique identifier of the credential.",
+							Computed:            true,
+						},
+						"slug": schema.StringAttribute{
+							MarkdownDescription: "URL-safe identifier of the credential, unique within the zone.",
+							Computed:            true,
+						},
+						"organization_id": schema.StringAttribute{
+							MarkdownDescription: "The organization that owns the credential.",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "The time the credential was created, in RFC 3339 format.",
+							Computed:            true,
+						},
+						"updated_at": schema.StringAttribute{
+							MarkdownDescription: "The time the credential was last updated, in RFC 3339 format.",
+							Computed:            true,
+						},
+						"zone_id": schema.StringAttribute{
+							MarkdownDescription: "The zone this credential belongs to.",
+							Computed:            true,
+						},
+						"application_id": schema.StringAttribute{
+							MarkdownDescription: "The application this credential belongs to.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The type of the credential. One of `password`, `token`, `public-key`, `url` or `public`.",
+							Computed:            true,
+						},
+						"identifier": schema.StringAttribute{
+							MarkdownDescription: "The identifier of the credential. For `password`, `public-key` and `public` credentials this is the OAuth 2.0 client ID; for `url` credentials it is the URL of the client ID metadata document.",
+							Computed:            true,
+						},
+						"provider_id": schema.StringAttribute{
+							MarkdownDescription: "The provider that validates tokens for this credential. Only set for `token` credentials.",
+							Computed:            true,
+						},
+						"subject": schema.StringAttribute{
+							MarkdownDescription: "The subject claim (sub) that must match in the bearer token. Only set for `token` credentials. May be empty.",
+							Computed:            true,
+						},
+						"jwks_uri": schema.StringAttribute{
+							MarkdownDescription: "The JWKS URI used to verify the application's JWT assertions. Only set for `public-key` credentials.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ApplicationCredentialsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ClientWithResponses)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ApplicationCredentialsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ApplicationCredentialsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Follow the page cursor until every credential has been listed
+	data.Credentials = []ApplicationCredentialSummaryModel{}
+	unsupported := map[string]int{}
+	var cursor *string
+	for {
+		items, pageInfo, diags := d.listPage(ctx, &data, cursor)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		for _, cred := range items {
+			credType, err := applicationCredentialType(&cred)
+			if err != nil {
+				resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to determine application credential type, got error: %s", err))
+				return
+			}
+
+			// Filter on the discriminator before decoding, so credentials of other types never fail the read
+			if !data.Type.IsNull() && credType != data.Type.ValueString() {
+				continue
+			}
+
+			if !isSupportedApplicationCredentialType(credType) {
+				unsupported[credType]++
+				continue
+			}
+
+			var credData ApplicationCredentialSummaryModel
+			resp.Diagnostics.Append(updateApplicationCredentialSummaryModelFromAPIResponse(&cred, &credData)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			data.Credentials = append(data.Credentials, credData)
+		}
+
+		cursor = nextPageCursor(pageInfo)
+		if cursor == nil {
+			break
+		}
+	}
+
+	for _, credType := range slices.Sorted(maps.Keys(unsupported)) {
+		resp.Diagnostics.AddWarning(
+			"Unsupported Application Credential Type",
+			fmt.Sprintf("Skipped %d application credential(s) of type %q, which is not supported by this version of the provider.", unsupported[credType], credType),
+		)
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// listPage fetches a single page of credentials, either for one application or for the whole zone.
+func (d *ApplicationCredentialsDataSource) listPage(ctx context.Context, data *ApplicationCredentialsDataSourceModel, cursor *string) ([]client.ApplicationCredential, client.PageInfo, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !data.ApplicationID.IsNull() {
+		listResp, err := d.client.ListApplicationCredentialsForApplicationWithResponse(ctx, data.ZoneID.ValueString(), data.ApplicationID.ValueString(), &client.ListApplicationCredentialsForApplicationParams{
+			Cursor: cursor,
+		})
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to list application credentials, got error: %s", err))
+			return nil, client.PageInfo{}, diags
+		}
+
+		if listResp.StatusCode() != 200 {
+			diags.AddError(
+				"API Error",
+				fmt.Sprintf("Unable to list application credentials, got status %d: %s", listResp.StatusCode(), string(listResp.Body)),
+			)
+			return nil, client.PageInfo{}, diags
+		}
+
+		if listResp.JSON200 == nil {
+			diags.AddError("API Error", "Unable to list application credentials, no response body")
+			return nil, client.PageInfo{}, diags
+		}
+
+		return listResp.JSON200.Items, listResp.JSON200.PageInfo, diags
+	}
+
+	listResp, err := d.client.ListApplicationCredentialsWithResponse(ctx, data.ZoneID.ValueString(), &client.ListApplicationCredentialsParams{
+		Cursor: cursor,
+	})
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list application credentials, got error: %s", err))
+		return nil, client.PageInfo{}, diags
+	}
+
+	if listResp.StatusCode() != 200 {
+		diags.AddError(
+			"API Error",
+			fmt.Sprintf("Unable to list application credentials, got status %d: %s", listResp.StatusCode(), string(listResp.Body)),
+		)
+		return nil, client.PageInfo{}, diags
+	}
+
+	if listResp.JSON200 == nil {
+		diags.AddError("API Error", "Unable to list application credentials, no response body")
+		return nil, client.PageInfo{}, diags
+	}
+
+	return listResp.JSON200.Items, listResp.JSON200.PageInfo, diags
+}
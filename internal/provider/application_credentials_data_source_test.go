@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccApplicationCredentialsDataSource_basic(t *testing.T) {
+	zoneName := acctest.RandomWithPrefix("tftest-zone")
+	rName := acctest.RandomWithPrefix("tftest")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// List every credential of the application
+			{
+				Config: testAccApplicationCredentialsDataSourceConfig(zoneName, rName, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.keycard_application_credentials.test", "credentials.#", "5"),
+					// Password credential, without its secret
+					resource.TestCheckTypeSetElemNestedAttrs("data.keycard_application_credentials.test", "credentials.*", map[string]string{
+						"type": "password",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(
+						"data.keycard_application_credentials.test", "credentials.*.identifier",
+						"keycard_application_client_secret.test", "client_id",
+					),
+					// Token credential
+					resource.TestCheckTypeSetElemNestedAttrs("data.keycard_application_credentials.test", "credentials.*", map[string]string{
+						"type":    "token",
+						"subject": "system:serviceaccount:default:test",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(
+						"data.keycard_application_credentials.test", "credentials.*.provider_id",
+						"keycard_provider.test", "id",
+					),
+					// Public key credential
+					resource.TestCheckTypeSetElemNestedAttrs("data.keycard_application_credentials.test", "credentials.*", map[string]string{
+						"type":     "public-key",
+						"jwks_uri": fmt.Sprintf("https://%s.example.com/.well-known/jwks.json", rName),
+					}),
+					// URL credential
+					resource.TestCheckTypeSetElemNestedAttrs("data.keycard_application_credentials.test", "credentials.*", map[string]string{
+						"type":       "url",
+						"identifier": fmt.Sprintf("https://%s.example.com/oauth_client", rName),
+					}),
+					// Public credential
+					resource.TestCheckTypeSetElemNestedAttrs("data.keycard_application_credentials.test", "credentials.*", map[string]string{
+						"type":       "public",
+						"identifier": fmt.Sprintf("%s-cli", rName),
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccApplicationCredentialsDataSource_typeFilter(t *testing.T) {
+	zoneName := acctest.RandomWithPrefix("tftest-zone")
+	rName := acctest.RandomWithPrefix("tftest")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationCredentialsDataSourceConfig(zoneName, rName, `type = "token"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.keycard_application_credentials.test", "credentials.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.keycard_application_credentials.test", "credentials.0.id",
+						"keycard_application_workload_identity.test", "id",
+					),
+					resource.TestCheckResourceAttr("data.keycard_application_credentials.test", "credentials.0.type", "token"),
+					resource.TestCheckNoResourceAttr("data.keycard_application_credentials.test", "credentials.0.jwks_uri"),
+				),
+			},
+		},
+	})
+}
+
+func testAccApplicationCredentialsDataSourceConfig(zoneName, appName, filters string) string {
+	return fmt.Sprintf(`
+resource "keycard_zone" "test" {
+  name = %[1]q
+}
+
+resource "keycard_provider" "test" {
+  name       = "k8s-provider-%[2]s"
+  identifier = "https://kubernetes.default.svc.cluster.local"
+  zone_id    = keycard_zone.test.id
+}
+
+resource "keycard_application" "test" {
+  name       = %[2]q
+  identifier = "https://%[2]s.example.com"
+  zone_id    = keycard_zone.test.id
+}
+
+resource "keycard_application_client_secret" "test" {
+  zone_id        = keycard_zone.test.id
+  application_id = keycard_application.test.id
+}
+
+resource "keycard_application_workload_identity" "test" {
+  zone_id        = keycard_zone.test.id
+  application_id = keycard_application.test.id
+  provider_id    = keycard_provider.test.id
+  subject        = "system:serviceaccount:default:test"
+}
+
+resource "keycard_application_public_key_credential" "test" {
+  zone_id        = keycard_zone.test.id
+  application_id = keycard_application.test.id
+  jwks_uri       = "https://%[2]s.example.com/.well-known/jwks.json"
+}
+
+resource "keycard_application_url_credential" "test" {
+  zone_id        = keycard_zone.test.id
+  application_id = keycard_application.test.id
+  url            = "https://%[2]s.example.com/oauth_client"
+}
+
+resource "keycard_application_public_credential" "test" {
+  zone_id        = keycard_zone.test.id
+  application_id = keycard_application.test.id
+  identifier     = "%[2]s-cli"
+}
+
+data "keycard_application_credentials" "test" {
+  zone_id        = keycard_zone.test.id
+  application_id = keycard_application.test.id
+  %[3]s
+
+  depends_on = [
+    keycard_application_client_secret.test,
+    keycard_application_workload_identity.test,
+    keycard_application_public_key_credential.test,
+    keycard_application_url_credential.test,
+    keycard_application_public_credential.test,
+  ]
+}
+`, zoneName, appName, filters)
+}
@@ -33,56 +33,28 @@ func (d *ApplicationDataSource) Schema(ctx context.Context, req datasource.Schem
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Reads a Keycard application. An application is a software system with an associated identity that can access resources.",
 
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				MarkdownDescription: "Unique identifier of the application. Either `id` or `identifier` must be provided, but not both.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"zone_id": schema.StringAttribute{
-				MarkdownDescription: "The zone this application belongs to.",
-				Required:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "Human-readable name for the application.",
-				Computed:            true,
-			},
-			"description": schema.StringAttribute{
-				MarkdownDescription: "Optional description of the application's purpose. May be empty.",
-				Computed:            true,
-			},
-			"identifier": schema.StringAttribute{
-				MarkdownDescription: "User-specified identifier for the application, typically its URL or URN. Must be unique within the zone. Either `id` or `identifier` must be provided, but not both.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"metadata": schema.SingleNestedAttribute{
-				MarkdownDescription: "Metadata associated with the application. May be empty.",
-				Computed:            true,
-				Attributes: map[string]schema.Attribute{
-					"docs_url": schema.StringAttribute{
-						MarkdownDescription: "URL to documentation relevant to this application. May be empty.",
-						Computed:            true,
-					},
-				},
-			},
-			"oauth2": schema.SingleNestedAttribute{
-				MarkdownDescription: "OAuth2 configuration for the application. May be empty.",
-				Computed:            true,
-				Attributes: map[string]schema.Attribute{
-					"redirect_uris": schema.ListAttribute{
-						MarkdownDescription: "OAuth 2.0 redirect URIs for authorization code/token delivery. May be empty.",
-						ElementType:         types.StringType,
-						Computed:            true,
-					},
-				},
-			},
-			"traits": schema.ListAttribute{
-				MarkdownDescription: "Traits of the application. Traits ascribe behaviors and characteristics to an application. May be empty.",
-				ElementType:         types.StringType,
-				Computed:            true,
-			},
-		},
+		Attributes: applicationDataSourceAttributes(),
+	}
+
+	// The application is looked up by either ID, identifier or slug within a zone
+	resp.Schema.Attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "Unique identifier of the application. Exactly one of `id`, `identifier` or `slug` must be provided.",
+		Optional:            true,
+		Computed:            true,
+	}
+	resp.Schema.Attributes["zone_id"] = schema.StringAttribute{
+		MarkdownDescription: "The zone this application belongs to.",
+		Required:            true,
+	}
+	resp.Schema.Attributes["identifier"] = schema.StringAttribute{
+		MarkdownDescription: "User-specified identifier for the application, typically its URL or URN. Must be unique within the zone. Exactly one of `id`, `identifier` or `slug` must be provided.",
+		Optional:            true,
+		Computed:            true,
+	}
+	resp.Schema.Attributes["slug"] = schema.StringAttribute{
+		MarkdownDescription: "URL-safe identifier of the application, unique within the zone. Exactly one of `id`, `identifier` or `slug` must be provided.",
+		Optional:            true,
+		Computed:            true,
 	}
 }
 
@@ -111,6 +83,7 @@ func (d *ApplicationDataSource) ConfigValidators(ctx context.Context) []datasour
 		datasourcevalidator.ExactlyOneOf(
 			path.MatchRoot("id"),
 			path.MatchRoot("identifier"),
+			path.MatchRoot("slug"),
 		),
 	}
 }
@@ -158,11 +131,17 @@ func (d *ApplicationDataSource) Read(ctx context.Context, req datasource.ReadReq
 
 		application = getResp.JSON200
 	} else {
-		// Lookup by identifier
-		identifier := data.Identifier.ValueString()
-		listResp, err := d.client.ListApplicationsWithResponse(ctx, data.ZoneID.ValueString(), &client.ListApplicationsParams{
-			Identifier: &identifier,
-		})
+		// Lookup by identifier or slug
+		params := client.ListApplicationsParams{}
+		selector := fmt.Sprintf("identifier '%s'", data.Identifier.ValueString())
+		if !data.Identifier.IsNull() {
+			params.Identifier = data.Identifier.ValueStringPointer()
+		} else {
+			params.Slug = data.Slug.ValueStringPointer()
+			selector = fmt.Sprintf("slug '%s'", data.Slug.ValueString())
+		}
+
+		listResp, err := d.client.ListApplicationsWithResponse(ctx, data.ZoneID.ValueString(), &params)
 		if err != nil {
 			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list applications: %s", err))
 			return
@@ -177,7 +156,7 @@ func (d *ApplicationDataSource) Read(ctx context.Context, req datasource.ReadReq
 		if resultCount == 0 {
 			resp.Diagnostics.AddError(
 				"Application Not Found",
-				fmt.Sprintf("No application found with identifier '%s' in zone '%s'", identifier, data.ZoneID.ValueString()),
+				fmt.Sprintf("No application found with %s in zone '%s'", selector, data.ZoneID.ValueString()),
 			)
 			return
 		}
@@ -185,8 +164,8 @@ func (d *ApplicationDataSource) Read(ctx context.Context, req datasource.ReadReq
 		if resultCount > 1 {
 			resp.Diagnostics.AddError(
 				"Multiple Applications Found",
-				fmt.Sprintf("Expected exactly 1 application with identifier '%s' in zone '%s', but found %d. This indicates a data integrity issue.",
-					identifier, data.ZoneID.ValueString(), resultCount),
+				fmt.Sprintf("Expected exactly 1 application with %s in zone '%s', but found %d. This indicates a data integrity issue.",
+					selector, data.ZoneID.ValueString(), resultCount),
 			)
 			return
 		}
@@ -203,3 +182,76 @@ func (d *ApplicationDataSource) Read(ctx context.Context, req datasource.ReadReq
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// applicationDataSourceAttributes returns the computed attributes describing an application in data sources.
+// Data sources that look up a single application override the attributes used as lookup arguments.
+func applicationDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Unique identifier of the application.",
+			Computed:            true,
+		},
+		"slug": schema.StringAttribute{
+			MarkdownDescription: "URL-safe identifier of the application, unique within the zone.",
+			Computed:            true,
+		},
+		"organization_id": schema.StringAttribute{
+			MarkdownDescription: "The organization that owns the application.",
+			Computed:            true,
+		},
+		"created_at": schema.StringAttribute{
+			MarkdownDescription: "The time the application was created, in RFC 3339 format.",
+			Computed:            true,
+		},
+		"updated_at": schema.StringAttribute{
+			MarkdownDescription: "The time the application was last updated, in RFC 3339 format.",
+			Computed:            true,
+		},
+		"zone_id": schema.StringAttribute{
+			MarkdownDescription: "The zone this application belongs to.",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Human-readable name for the application.",
+			Computed:            true,
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "Optional description of the application's purpose. May be empty.",
+			Computed:            true,
+		},
+		"identifier": schema.StringAttribute{
+			MarkdownDescription: "User-specified identifier for the application, typically its URL or URN. Unique within the zone.",
+			Computed:            true,
+		},
+		"metadata": schema.SingleNestedAttribute{
+			MarkdownDescription: "Metadata associated with the application. May be empty.",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"docs_url": schema.StringAttribute{
+					MarkdownDescription: "URL to documentation relevant to this application. May be empty.",
+					Computed:            true,
+				},
+			},
+		},
+		"oauth2": schema.SingleNestedAttribute{
+			MarkdownDescription: "OAuth2 configuration for the application. May be empty.",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"redirect_uris": schema.ListAttribute{
+					MarkdownDescription: "OAuth 2.0 redirect URIs for authorization code/token delivery. May be empty.",
+					ElementType:         types.StringType,
+					Computed:            true,
+				},
+			},
+		},
+		"dependencies_count": schema.Int64Attribute{
+			MarkdownDescription: "Number of resources the application depends on.",
+			Computed:            true,
+		},
+		"traits": schema.ListAttribute{
+			MarkdownDescription: "Traits of the application. Traits ascribe behaviors and characteristics to an application. May be empty.",
+			ElementType:         types.StringType,
+			Computed:            true,
+		},
+	}
+}
@@ -380,3 +380,60 @@ data "keycard_application" "test" {
 }
 `, zoneName)
 }
+
+func TestAccApplicationDataSource_bySlug(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tftest")
+	zoneName := acctest.RandomWithPrefix("tftest-zone")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create an application and fetch it by slug
+			{
+				Config: testAccApplicationDataSourceConfig_bySlug(zoneName, rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.keycard_application.test", "id",
+						"keycard_application.test", "id",
+					),
+					resource.TestCheckResourceAttrPair(
+						"data.keycard_application.test", "slug",
+						"keycard_application.test", "slug",
+					),
+					resource.TestCheckResourceAttrPair(
+						"data.keycard_application.test", "organization_id",
+						"keycard_application.test", "organization_id",
+					),
+					resource.TestCheckResourceAttrPair(
+						"data.keycard_application.test", "created_at",
+						"keycard_application.test", "created_at",
+					),
+					resource.TestCheckResourceAttrPair(
+						"data.keycard_application.test", "updated_at",
+						"keycard_application.test", "updated_at",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testAccApplicationDataSourceConfig_bySlug(zoneName, appName string) string {
+	return fmt.Sprintf(`
+resource "keycard_zone" "test" {
+  name = %[1]q
+}
+
+resource "keycard_application" "test" {
+  name       = %[2]q
+  identifier = "https://%[2]s.example.com"
+  zone_id    = keycard_zone.test.id
+}
+
+data "keycard_application" "test" {
+  zone_id = keycard_application.test.zone_id
+  slug    = keycard_application.test.slug
+}
+`, zoneName, appName)
+}
@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/keycardai/terraform-provider-keycard/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ApplicationDependenciesDataSource{}
+
+func NewApplicationDependenciesDataSource() datasource.DataSource {
+	return &ApplicationDependenciesDataSource{}
+}
+
+// ApplicationDependenciesDataSource defines the data source implementation.
+type ApplicationDependenciesDataSource struct {
+	client *client.ClientWithResponses
+}
+
+// ApplicationDependenciesDataSourceModel describes the data source data model.
+type ApplicationDependenciesDataSourceModel struct {
+	ZoneID        types.String                        `tfsdk:"zone_id"`
+	ApplicationID types.String                        `tfsdk:"application_id"`
+	WhenAccessing types.String                        `tfsdk:"when_accessing"`
+	Dependencies  []ApplicationDependencySummaryModel `tfsdk:"dependencies"`
+}
+
+// ApplicationDependencySummaryModel describes a resource that an application depends on.
+type ApplicationDependencySummaryModel struct {
+	ResourceID    types.String `tfsdk:"resource_id"`
+	Identifier    types.String `tfsdk:"identifier"`
+	Name          types.String `tfsdk:"name"`
+	WhenAccessing types.Set    `tfsdk:"when_accessing"`
+}
+
+func (d *ApplicationDependenciesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application_dependencies"
+}
+
+func (d *ApplicationDependenciesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the resources a Keycard application depends on, including dependencies created outside Terraform.",
+
+		Attributes: map[string]schema.Attribute{
+			"zone_id": schema.StringAttribute{
+				MarkdownDescription: "The zone the application belongs to.",
+				Required:            true,
+			},
+			"application_id": schema.StringAttribute{
+				MarkdownDescription: "The application to list dependencies for.",
+				Required:            true,
+			},
+			"when_accessing": schema.StringAttribute{
+				MarkdownDescription: "Only return dependencies that are available when the application accesses the resource with this ID.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"dependencies": schema.ListNestedAttribute{
+				MarkdownDescription: "The resources the application depends on.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"resource_id": schema.StringAttribute{
+							MarkdownDescription: "Unique identifier of the resource the application depends on.",
+							Computed:            true,
+						},
+						"identifier": schema.StringAttribute{
+							MarkdownDescription: "User-specified identifier of the resource, typically its URL or URN.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Human-readable name of the resource.",
+							Computed:            true,
+						},
+						"when_accessing": schema.SetAttribute{
+							MarkdownDescription: "Resource IDs that, when accessed by the application, make this dependency available. May be empty.",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ApplicationDependenciesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ClientWithResponses)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ApplicationDependenciesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ApplicationDependenciesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := client.ListApplicationDependenciesParams{}
+	if !data.WhenAccessing.IsNull() {
+		params.WhenAccessing = data.WhenAccessing.ValueStringPointer()
+	}
+
+	// Follow the page cursor until every dependency has been listed
+	data.Dependencies = []ApplicationDependencySummaryModel{}
+	for {
+		listResp, err := d.client.ListApplicationDependenciesWithResponse(ctx, data.ZoneID.ValueString(), data.ApplicationID.ValueString(), &params)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list application dependencies, got error: %s", err))
+			return
+		}
+
+		if listResp.StatusCode() != 200 {
+			resp.Diagnostics.AddError(
+				"API Error",
+				fmt.Sprintf("Unable to list application dependencies, got status %d: %s", listResp.StatusCode(), string(listResp.Body)),
+			)
+			return
+		}
+
+		if listResp.JSON200 == nil {
+			resp.Diagnostics.AddError("API Error", "Unable to list application dependencies, no response body")
+			return
+		}
+
+		for _, dependency := range listResp.JSON200.Items {
+			dependencyData := ApplicationDependencySummaryModel{
+				ResourceID: types.StringValue(dependency.Id),
+				Identifier: types.StringValue(dependency.Identifier),
+				Name:       types.StringValue(dependency.Name),
+			}
+
+			if dependency.WhenAccessing != nil {
+				whenAccessingSet, diags := types.SetValueFrom(ctx, types.StringType, dependency.WhenAccessing)
+				resp.Diagnostics.Append(diags...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+				dependencyData.WhenAccessing = whenAccessingSet
+			} else {
+				dependencyData.WhenAccessing = types.SetNull(types.StringType)
+			}
+
+			data.Dependencies = append(data.Dependencies, dependencyData)
+		}
+
+		params.Cursor = nextPageCursor(listResp.JSON200.PageInfo)
+		if params.Cursor == nil {
+			break
+		}
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
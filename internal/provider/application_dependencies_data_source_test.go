@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccApplicationDependenciesDataSource_basic(t *testing.T) {
+	zoneName := acctest.RandomWithPrefix("tftest-zone")
+	rName := acctest.RandomWithPrefix("tftest")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// List every dependency of the application
+			{
+				Config: testAccApplicationDependenciesDataSourceConfig(zoneName, rName, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.keycard_application_dependencies.test", "dependencies.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(
+						"data.keycard_application_dependencies.test", "dependencies.*.resource_id",
+						"keycard_resource.calendar", "id",
+					),
+					resource.TestCheckTypeSetElemAttrPair(
+						"data.keycard_application_dependencies.test", "dependencies.*.resource_id",
+						"keycard_resource.drive", "id",
+					),
+					resource.TestCheckTypeSetElemNestedAttrs("data.keycard_application_dependencies.test", "dependencies.*", map[string]string{
+						"identifier": fmt.Sprintf("https://%s-calendar.example.com", rName),
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccApplicationDependenciesDataSource_whenAccessing(t *testing.T) {
+	zoneName := acctest.RandomWithPrefix("tftest-zone")
+	rName := acctest.RandomWithPrefix("tftest")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Only the dependency scoped to the gateway resource is returned
+			{
+				Config: testAccApplicationDependenciesDataSourceConfig(zoneName, rName, `when_accessing = keycard_resource.gateway.id`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.keycard_application_dependencies.test", "dependencies.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.keycard_application_dependencies.test", "dependencies.0.resource_id",
+						"keycard_resource.drive", "id",
+					),
+					resource.TestCheckResourceAttr("data.keycard_application_dependencies.test", "dependencies.0.when_accessing.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.keycard_application_dependencies.test", "dependencies.0.when_accessing.0",
+						"keycard_resource.gateway", "id",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testAccApplicationDependenciesDataSourceConfig(zoneName, name, filters string) string {
+	return fmt.Sprintf(`
+resource "keycard_zone" "test" {
+  name = %[1]q
+}
+
+resource "keycard_provider" "test" {
+  name       = %[2]q
+  identifier = "https://%[2]s.example.com"
+  zone_id    = keycard_zone.test.id
+}
+
+resource "keycard_application" "test" {
+  name       = %[2]q
+  identifier = "https://%[2]s-app.example.com"
+  zone_id    = keycard_zone.test.id
+}
+
+resource "keycard_resource" "gateway" {
+  name                   = "%[2]s-gateway"
+  identifier             = "https://%[2]s-gateway.example.com"
+  zone_id                = keycard_zone.test.id
+  credential_provider_id = keycard_provider.test.id
+}
+
+resource "keycard_resource" "calendar" {
+  name                   = "%[2]s-calendar"
+  identifier             = "https://%[2]s-calendar.example.com"
+  zone_id                = keycard_zone.test.id
+  credential_provider_id = keycard_provider.test.id
+}
+
+resource "keycard_resource" "drive" {
+  name                   = "%[2]s-drive"
+  identifier             = "https://%[2]s-drive.example.com"
+  zone_id                = keycard_zone.test.id
+  credential_provider_id = keycard_provider.test.id
+}
+
+resource "keycard_application_dependency" "calendar" {
+  zone_id        = keycard_zone.test.id
+  application_id = keycard_application.test.id
+  resource_id    = keycard_resource.calendar.id
+}
+
+resource "keycard_application_dependency" "drive" {
+  zone_id        = keycard_zone.test.id
+  application_id = keycard_application.test.id
+  resource_id    = keycard_resource.drive.id
+  when_accessing = [keycard_resource.gateway.id]
+}
+
+data "keycard_application_dependencies" "test" {
+  zone_id        = keycard_zone.test.id
+  application_id = keycard_application.test.id
+  %[3]s
+
+  depends_on = [keycard_application_dependency.calendar, keycard_application_dependency.drive]
+}
+`, zoneName, name, filters)
+}
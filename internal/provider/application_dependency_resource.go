@@ -5,11 +5,11 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/keycardai/terraform-provider-keycard/internal/client"
@@ -69,12 +69,9 @@ func (r *ApplicationDependencyResource) Schema(ctx context.Context, req resource
 				},
 			},
 			"when_accessing": schema.SetAttribute{
-				MarkdownDescription: "Filter the dependency to be active only when accessing specific resources provided by the application. Changing this will replace the dependency.",
+				MarkdownDescription: "Filter the dependency to be active only when accessing specific resources provided by the application. Changes are applied in place, without removing the dependency.",
 				ElementType:         types.StringType,
 				Optional:            true,
-				PlanModifiers: []planmodifier.Set{
-					setplanmodifier.RequiresReplace(),
-				},
 			},
 		},
 	}
@@ -111,16 +108,10 @@ func (r *ApplicationDependencyResource) Create(ctx context.Context, req resource
 	}
 
 	// Prepare the when_accessing parameter
-	var params *client.AddApplicationDependencyParams
-	if !data.WhenAccessing.IsNull() && !data.WhenAccessing.IsUnknown() {
-		var whenAccessingSlice []string
-		resp.Diagnostics.Append(data.WhenAccessing.ElementsAs(ctx, &whenAccessingSlice, false)...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		params = &client.AddApplicationDependencyParams{
-			WhenAccessing: &whenAccessingSlice,
-		}
+	params, diags := applicationDependencyParams(ctx, data.WhenAccessing)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	// Add the application dependency
@@ -206,8 +197,7 @@ func (r *ApplicationDependencyResource) Read(ctx context.Context, req resource.R
 }
 
 func (r *ApplicationDependencyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// All fields are immutable (RequiresReplace), so this should never be called.
-	// If it is called, we just need to read the plan and set it as the new state.
+	// Only when_accessing can change in place, every other field requires replacement
 	var data ApplicationDependencyModel
 
 	// Read Terraform plan data into the model
@@ -217,6 +207,35 @@ func (r *ApplicationDependencyResource) Update(ctx context.Context, req resource
 		return
 	}
 
+	// Prepare the when_accessing parameter
+	params, diags := applicationDependencyParams(ctx, data.WhenAccessing)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Adding a dependency is idempotent, so putting it again replaces when_accessing
+	// without the dependency ever being removed
+	updateResp, err := r.client.AddApplicationDependencyWithResponse(
+		ctx,
+		data.ZoneID.ValueString(),
+		data.ApplicationID.ValueString(),
+		data.ResourceID.ValueString(),
+		params,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update application dependency, got error: %s", err))
+		return
+	}
+
+	if updateResp.StatusCode() != 204 {
+		resp.Diagnostics.AddError(
+			"API Error",
+			fmt.Sprintf("Unable to update application dependency, got status %d: %s", updateResp.StatusCode(), string(updateResp.Body)),
+		)
+		return
+	}
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -272,3 +291,18 @@ func (r *ApplicationDependencyResource) ImportState(ctx context.Context, req res
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("application_id"), applicationID)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("resource_id"), resourceID)...)
 }
+
+// applicationDependencyParams builds the query parameters adding a dependency with the given
+// when_accessing set. A null set adds an unconditional dependency.
+func applicationDependencyParams(ctx context.Context, whenAccessing types.Set) (*client.AddApplicationDependencyParams, diag.Diagnostics) {
+	if whenAccessing.IsNull() || whenAccessing.IsUnknown() {
+		return nil, nil
+	}
+
+	var whenAccessingSlice []string
+	diags := whenAccessing.ElementsAs(ctx, &whenAccessingSlice, false)
+
+	return &client.AddApplicationDependencyParams{
+		WhenAccessing: &whenAccessingSlice,
+	}, diags
+}
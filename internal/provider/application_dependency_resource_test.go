@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
@@ -392,3 +393,124 @@ resource "keycard_application_dependency" "test" {
 }
 `, zoneName, providerName, appName, resourceName, additionalResourceName)
 }
+
+func TestAccApplicationDependencyResource_whenAccessingUpdate(t *testing.T) {
+	zoneName := acctest.RandomWithPrefix("tftest-zone")
+	providerName := acctest.RandomWithPrefix("tftest-provider")
+	appName := acctest.RandomWithPrefix("tftest-app")
+	resourceName := acctest.RandomWithPrefix("tftest-resource")
+	additionalResourceName1 := acctest.RandomWithPrefix("tftest-additional1")
+	additionalResourceName2 := acctest.RandomWithPrefix("tftest-additional2")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create an unconditional dependency
+			{
+				Config: testAccApplicationDependencyResourceConfig_whenAccessingList(zoneName, providerName, appName, resourceName, additionalResourceName1, additionalResourceName2, nil),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("keycard_application_dependency.test", "when_accessing"),
+				),
+			},
+			// Restrict the dependency in place
+			{
+				Config: testAccApplicationDependencyResourceConfig_whenAccessingList(zoneName, providerName, appName, resourceName, additionalResourceName1, additionalResourceName2, []string{"additional1"}),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("keycard_application_dependency.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("keycard_application_dependency.test", "when_accessing.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("keycard_application_dependency.test", "when_accessing.*", "keycard_resource.additional1", "id"),
+				),
+			},
+			// Change the access context in place
+			{
+				Config: testAccApplicationDependencyResourceConfig_whenAccessingList(zoneName, providerName, appName, resourceName, additionalResourceName1, additionalResourceName2, []string{"additional1", "additional2"}),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("keycard_application_dependency.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("keycard_application_dependency.test", "when_accessing.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair("keycard_application_dependency.test", "when_accessing.*", "keycard_resource.additional1", "id"),
+					resource.TestCheckTypeSetElemAttrPair("keycard_application_dependency.test", "when_accessing.*", "keycard_resource.additional2", "id"),
+				),
+			},
+			// Make the dependency unconditional again in place
+			{
+				Config: testAccApplicationDependencyResourceConfig_whenAccessingList(zoneName, providerName, appName, resourceName, additionalResourceName1, additionalResourceName2, nil),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("keycard_application_dependency.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("keycard_application_dependency.test", "when_accessing"),
+				),
+			},
+		},
+	})
+}
+
+func testAccApplicationDependencyResourceConfig_whenAccessingList(zoneName, providerName, appName, resourceName, additionalResourceName1, additionalResourceName2 string, whenAccessing []string) string {
+	config := fmt.Sprintf(`
+resource "keycard_zone" "test" {
+  name = %[1]q
+}
+
+resource "keycard_provider" "test" {
+  name       = %[2]q
+  identifier = "https://%[2]s.example.com"
+  zone_id    = keycard_zone.test.id
+}
+
+resource "keycard_application" "test" {
+  name       = %[3]q
+  identifier = "https://%[3]s.example.com"
+  zone_id    = keycard_zone.test.id
+}
+
+resource "keycard_resource" "test" {
+  name                   = %[4]q
+  identifier             = "https://%[4]s.example.com"
+  zone_id                = keycard_zone.test.id
+  credential_provider_id = keycard_provider.test.id
+}
+
+resource "keycard_resource" "additional1" {
+  name                   = %[5]q
+  identifier             = "https://%[5]s.example.com"
+  zone_id                = keycard_zone.test.id
+  credential_provider_id = keycard_provider.test.id
+  application_id         = keycard_application.test.id
+}
+
+resource "keycard_resource" "additional2" {
+  name                   = %[6]q
+  identifier             = "https://%[6]s.example.com"
+  zone_id                = keycard_zone.test.id
+  credential_provider_id = keycard_provider.test.id
+  application_id         = keycard_application.test.id
+}
+
+resource "keycard_application_dependency" "test" {
+  zone_id        = keycard_zone.test.id
+  application_id = keycard_application.test.id
+  resource_id    = keycard_resource.test.id
+`, zoneName, providerName, appName, resourceName, additionalResourceName1, additionalResourceName2)
+
+	if whenAccessing != nil {
+		config += "  when_accessing = [\n"
+		for _, name := range whenAccessing {
+			config += fmt.Sprintf("    keycard_resource.%s.id,\n", name)
+		}
+		config += "  ]\n"
+	}
+
+	config += "}\n"
+	return config
+}
@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccApplicationPublicCredentialResource_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tftest")
+	zoneName := acctest.RandomWithPrefix("tftest-zone")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccApplicationPublicCredentialResourceConfig_basic(zoneName, rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("keycard_application_public_credential.test", "id"),
+					resource.TestCheckResourceAttrSet("keycard_application_public_credential.test", "slug"),
+					resource.TestCheckResourceAttrSet("keycard_application_public_credential.test", "organization_id"),
+					resource.TestCheckResourceAttrSet("keycard_application_public_credential.test", "created_at"),
+					resource.TestCheckResourceAttrSet("keycard_application_public_credential.test", "updated_at"),
+					resource.TestCheckResourceAttrSet("keycard_application_public_credential.test", "zone_id"),
+					resource.TestCheckResourceAttrSet("keycard_application_public_credential.test", "application_id"),
+					resource.TestCheckResourceAttrSet("keycard_application_public_credential.test", "identifier"),
+					// Verify relationships
+					resource.TestCheckResourceAttrPair(
+						"keycard_application_public_credential.test", "zone_id",
+						"keycard_zone.test", "id",
+					),
+					resource.TestCheckResourceAttrPair(
+						"keycard_application_public_credential.test", "application_id",
+						"keycard_application.test", "id",
+					),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "keycard_application_public_credential.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["keycard_application_public_credential.test"]
+					if !ok {
+						return "", fmt.Errorf("Not found: keycard_application_public_credential.test")
+					}
+					zoneID := rs.Primary.Attributes["zone_id"]
+					id := rs.Primary.ID
+					return fmt.Sprintf("zones/%s/application-credentials/%s", zoneID, id), nil
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccApplicationPublicCredentialResource_updateIdentifier(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tftest")
+	zoneName := acctest.RandomWithPrefix("tftest-zone")
+	identifier1 := fmt.Sprintf("%s-cli", rName)
+	identifier2 := fmt.Sprintf("%s-spa", rName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with first identifier
+			{
+				Config: testAccApplicationPublicCredentialResourceConfig_withIdentifier(zoneName, rName, identifier1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("keycard_application_public_credential.test", "id"),
+					resource.TestCheckResourceAttr("keycard_application_public_credential.test", "identifier", identifier1),
+				),
+			},
+			// Change identifier (should update in place)
+			{
+				Config: testAccApplicationPublicCredentialResourceConfig_withIdentifier(zoneName, rName, identifier2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("keycard_application_public_credential.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("keycard_application_public_credential.test", "id"),
+					resource.TestCheckResourceAttr("keycard_application_public_credential.test", "identifier", identifier2),
+				),
+			},
+		},
+	})
+}
+
+func testAccApplicationPublicCredentialResourceConfig_basic(zoneName, appName string) string {
+	return fmt.Sprintf(`
+resource "keycard_zone" "test" {
+  name = %[1]q
+}
+
+resource "keycard_application" "test" {
+  name       = %[2]q
+  identifier = "https://%[2]s.example.com"
+  zone_id    = keycard_zone.test.id
+}
+
+resource "keycard_application_public_credential" "test" {
+  zone_id        = keycard_zone.test.id
+  application_id = keycard_application.test.id
+}
+`, zoneName, appName)
+}
+
+func testAccApplicationPublicCredentialResourceConfig_withIdentifier(zoneName, appName, identifier string) string {
+	return fmt.Sprintf(`
+resource "keycard_zone" "test" {
+  name = %[1]q
+}
+
+resource "keycard_application" "test" {
+  name       = %[2]q
+  identifier = "https://%[2]s.example.com"
+  zone_id    = keycard_zone.test.id
+}
+
+resource "keycard_application_public_credential" "test" {
+  zone_id        = keycard_zone.test.id
+  application_id = keycard_application.test.id
+  identifier     = %[3]q
+}
+`, zoneName, appName, identifier)
+}
@@ -0,0 +1,307 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/keycardai/terraform-provider-keycard/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &ApplicationPublicKeyCredentialResource{}
+	_ resource.ResourceWithImportState = &ApplicationPublicKeyCredentialResource{}
+)
+
+func NewApplicationPublicKeyCredentialResource() resource.Resource {
+	return &ApplicationPublicKeyCredentialResource{}
+}
+
+// ApplicationPublicKeyCredentialResource defines the resource implementation.
+type ApplicationPublicKeyCredentialResource struct {
+	client *client.ClientWithResponses
+}
+
+// ApplicationPublicKeyCredentialModel describes the application public key credential data model.
+type ApplicationPublicKeyCredentialModel struct {
+	ID             types.String `tfsdk:"id"`
+	ZoneID         types.String `tfsdk:"zone_id"`
+	Slug           types.String `tfsdk:"slug"`
+	OrganizationID types.String `tfsdk:"organization_id"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+	UpdatedAt      types.String `tfsdk:"updated_at"`
+	ApplicationID  types.String `tfsdk:"application_id"`
+	JwksURI        types.String `tfsdk:"jwks_uri"`
+	Identifier     types.String `tfsdk:"identifier"`
+}
+
+func (r *ApplicationPublicKeyCredentialResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application_public_key_credential"
+}
+
+func (r *ApplicationPublicKeyCredentialResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a public key credential for a Keycard application. " +
+			"Public key credentials allow an application to authenticate to the Keycard zone with signed JWT assertions " +
+			"(`private_key_jwt`), verified against the keys published at a JWKS URI, instead of a shared secret.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the credential.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"slug": schema.StringAttribute{
+				MarkdownDescription: "URL-safe identifier of the credential, unique within the zone.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "The organization that owns the credential.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The time the credential was created, in RFC 3339 format.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "The time the credential was last updated, in RFC 3339 format.",
+				Computed:            true,
+			},
+			"zone_id": schema.StringAttribute{
+				MarkdownDescription: "The zone this credential belongs to. Changing this will replace the credential.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"application_id": schema.StringAttribute{
+				MarkdownDescription: "The application this credential belongs to. Changing this will replace the credential.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"jwks_uri": schema.StringAttribute{
+				MarkdownDescription: "The JWKS URI from which the public keys used to verify the application's JWT assertions are retrieved. Changing this will replace the credential.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"identifier": schema.StringAttribute{
+				MarkdownDescription: "The OAuth 2.0 client ID for this credential. Auto-generated when not specified. Changing this will replace the credential.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ApplicationPublicKeyCredentialResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ClientWithResponses)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ApplicationPublicKeyCredentialResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ApplicationPublicKeyCredentialModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build the create request for a public-key-type credential
+	var identifierPtr *string
+	if !data.Identifier.IsNull() && !data.Identifier.IsUnknown() {
+		identifierPtr = data.Identifier.ValueStringPointer()
+	}
+
+	publicKeyCreate := client.ApplicationCredentialCreatePublicKey{
+		ApplicationId: data.ApplicationID.ValueString(),
+		Type:          client.ApplicationCredentialCreatePublicKeyTypePublicKey,
+		JwksUri:       data.JwksURI.ValueString(),
+		Identifier:    identifierPtr,
+	}
+
+	createReq := client.ApplicationCredentialCreate{}
+	err := createReq.FromApplicationCredentialCreatePublicKey(publicKeyCreate)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to construct application public key credential request body, got error: %s", err))
+		return
+	}
+
+	// Create the credential
+	createResp, err := r.client.CreateApplicationCredentialWithResponse(ctx, data.ZoneID.ValueString(), createReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create application public key credential, got error: %s", err))
+		return
+	}
+
+	if createResp.StatusCode() != 200 {
+		resp.Diagnostics.AddError(
+			"API Error",
+			fmt.Sprintf("Unable to create application public key credential, got status %d: %s", createResp.StatusCode(), string(createResp.Body)),
+		)
+		return
+	}
+
+	if createResp.JSON200 == nil {
+		resp.Diagnostics.AddError("API Error", "Unable to create application public key credential, no response body")
+		return
+	}
+
+	// Update the model with the response data
+	resp.Diagnostics.Append(updateApplicationPublicKeyCredentialModelFromCreateResponse(createResp.JSON200, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ApplicationPublicKeyCredentialResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ApplicationPublicKeyCredentialModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get the credential
+	getResp, err := r.client.GetApplicationCredentialWithResponse(ctx, data.ZoneID.ValueString(), data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read application public key credential, got error: %s", err))
+		return
+	}
+
+	if getResp.StatusCode() == 404 {
+		// Credential was deleted outside of Terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if getResp.StatusCode() != 200 {
+		resp.Diagnostics.AddError(
+			"API Error",
+			fmt.Sprintf("Unable to read application public key credential, got status %d: %s", getResp.StatusCode(), string(getResp.Body)),
+		)
+		return
+	}
+
+	if getResp.JSON200 == nil {
+		resp.Diagnostics.AddError("API Error", "Unable to read application public key credential, no response body")
+		return
+	}
+
+	// Update the model with the response data
+	resp.Diagnostics.Append(updateApplicationPublicKeyCredentialModelFromAPIResponse(getResp.JSON200, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ApplicationPublicKeyCredentialResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// This resource does not support updates - all attributes require replacement
+	// This method should never be called due to RequiresReplace plan modifiers
+	resp.Diagnostics.AddError(
+		"Update Not Supported",
+		"Application public key credentials are immutable. Any changes require resource replacement.",
+	)
+}
+
+func (r *ApplicationPublicKeyCredentialResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ApplicationPublicKeyCredentialModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete the credential
+	deleteResp, err := r.client.DeleteApplicationCredentialWithResponse(ctx, data.ZoneID.ValueString(), data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete application public key credential, got error: %s", err))
+		return
+	}
+
+	if deleteResp.StatusCode() != 204 && deleteResp.StatusCode() != 404 {
+		resp.Diagnostics.AddError(
+			"API Error",
+			fmt.Sprintf("Unable to delete application public key credential, got status %d: %s", deleteResp.StatusCode(), string(deleteResp.Body)),
+		)
+		return
+	}
+}
+
+func (r *ApplicationPublicKeyCredentialResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Parse import ID as zones/{zone-id}/application-credentials/{credential-id}
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 4 || parts[0] != "zones" || parts[2] != "application-credentials" || parts[1] == "" || parts[3] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID in format 'zones/{zone-id}/application-credentials/{credential-id}', got: %s", req.ID),
+		)
+		return
+	}
+
+	zoneID := parts[1]
+	credentialID := parts[3]
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone_id"), zoneID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), credentialID)...)
+}
@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccApplicationPublicKeyCredentialResource_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tftest")
+	zoneName := acctest.RandomWithPrefix("tftest-zone")
+	jwksURI := fmt.Sprintf("https://%s.example.com/.well-known/jwks.json", rName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccApplicationPublicKeyCredentialResourceConfig_basic(zoneName, rName, jwksURI),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("keycard_application_public_key_credential.test", "id"),
+					resource.TestCheckResourceAttrSet("keycard_application_public_key_credential.test", "slug"),
+					resource.TestCheckResourceAttrSet("keycard_application_public_key_credential.test", "organization_id"),
+					resource.TestCheckResourceAttrSet("keycard_application_public_key_credential.test", "created_at"),
+					resource.TestCheckResourceAttrSet("keycard_application_public_key_credential.test", "updated_at"),
+					resource.TestCheckResourceAttrSet("keycard_application_public_key_credential.test", "zone_id"),
+					resource.TestCheckResourceAttrSet("keycard_application_public_key_credential.test", "application_id"),
+					resource.TestCheckResourceAttrSet("keycard_application_public_key_credential.test", "identifier"),
+					resource.TestCheckResourceAttr("keycard_application_public_key_credential.test", "jwks_uri", jwksURI),
+					// Verify relationships
+					resource.TestCheckResourceAttrPair(
+						"keycard_application_public_key_credential.test", "zone_id",
+						"keycard_zone.test", "id",
+					),
+					resource.TestCheckResourceAttrPair(
+						"keycard_application_public_key_credential.test", "application_id",
+						"keycard_application.test", "id",
+					),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "keycard_application_public_key_credential.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["keycard_application_public_key_credential.test"]
+					if !ok {
+						return "", fmt.Errorf("Not found: keycard_application_public_key_credential.test")
+					}
+					zoneID := rs.Primary.Attributes["zone_id"]
+					id := rs.Primary.ID
+					return fmt.Sprintf("zones/%s/application-credentials/%s", zoneID, id), nil
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccApplicationPublicKeyCredentialResource_withIdentifier(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tftest")
+	zoneName := acctest.RandomWithPrefix("tftest-zone")
+	jwksURI := fmt.Sprintf("https://%s.example.com/.well-known/jwks.json", rName)
+	identifier := fmt.Sprintf("%s-client", rName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationPublicKeyCredentialResourceConfig_withIdentifier(zoneName, rName, jwksURI, identifier),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("keycard_application_public_key_credential.test", "id"),
+					resource.TestCheckResourceAttr("keycard_application_public_key_credential.test", "jwks_uri", jwksURI),
+					resource.TestCheckResourceAttr("keycard_application_public_key_credential.test", "identifier", identifier),
+				),
+			},
+		},
+	})
+}
+
+func TestAccApplicationPublicKeyCredentialResource_jwksURIChange(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tftest")
+	zoneName := acctest.RandomWithPrefix("tftest-zone")
+	jwksURI1 := fmt.Sprintf("https://%s-1.example.com/.well-known/jwks.json", rName)
+	jwksURI2 := fmt.Sprintf("https://%s-2.example.com/.well-known/jwks.json", rName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with first JWKS URI
+			{
+				Config: testAccApplicationPublicKeyCredentialResourceConfig_basic(zoneName, rName, jwksURI1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("keycard_application_public_key_credential.test", "id"),
+					resource.TestCheckResourceAttr("keycard_application_public_key_credential.test", "jwks_uri", jwksURI1),
+				),
+			},
+			// Change JWKS URI (should force replacement)
+			{
+				Config: testAccApplicationPublicKeyCredentialResourceConfig_basic(zoneName, rName, jwksURI2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("keycard_application_public_key_credential.test", "id"),
+					resource.TestCheckResourceAttr("keycard_application_public_key_credential.test", "jwks_uri", jwksURI2),
+				),
+			},
+		},
+	})
+}
+
+func testAccApplicationPublicKeyCredentialResourceConfig_basic(zoneName, appName, jwksURI string) string {
+	return fmt.Sprintf(`
+resource "keycard_zone" "test" {
+  name = %[1]q
+}
+
+resource "keycard_application" "test" {
+  name       = %[2]q
+  identifier = "https://%[2]s.example.com"
+  zone_id    = keycard_zone.test.id
+}
+
+resource "keycard_application_public_key_credential" "test" {
+  zone_id        = keycard_zone.test.id
+  application_id = keycard_application.test.id
+  jwks_uri       = %[3]q
+}
+`, zoneName, appName, jwksURI)
+}
+
+func testAccApplicationPublicKeyCredentialResourceConfig_withIdentifier(zoneName, appName, jwksURI, identifier string) string {
+	return fmt.Sprintf(`
+resource "keycard_zone" "test" {
+  name = %[1]q
+}
+
+resource "keycard_application" "test" {
+  name       = %[2]q
+  identifier = "https://%[2]s.example.com"
+  zone_id    = keycard_zone.test.id
+}
+
+resource "keycard_application_public_key_credential" "test" {
+  zone_id        = keycard_zone.test.id
+  application_id = keycard_application.test.id
+  jwks_uri       = %[3]q
+  identifier     = %[4]q
+}
+`, zoneName, appName, jwksURI, identifier)
+}
@@ -3,11 +3,14 @@ package provider
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -38,14 +41,27 @@ type ApplicationResource struct {
 // ApplicationModel describes the application data model.
 // This model is shared between the resource and data source.
 type ApplicationModel struct {
-	ID          types.String `tfsdk:"id"`
-	ZoneID      types.String `tfsdk:"zone_id"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
-	Identifier  types.String `tfsdk:"identifier"`
-	Metadata    types.Object `tfsdk:"metadata"`
-	OAuth2      types.Object `tfsdk:"oauth2"`
-	Traits      types.List   `tfsdk:"traits"`
+	ID                types.String `tfsdk:"id"`
+	ZoneID            types.String `tfsdk:"zone_id"`
+	Slug              types.String `tfsdk:"slug"`
+	OrganizationID    types.String `tfsdk:"organization_id"`
+	CreatedAt         types.String `tfsdk:"created_at"`
+	UpdatedAt         types.String `tfsdk:"updated_at"`
+	Name              types.String `tfsdk:"name"`
+	Description       types.String `tfsdk:"description"`
+	Identifier        types.String `tfsdk:"identifier"`
+	Metadata          types.Object `tfsdk:"metadata"`
+	OAuth2            types.Object `tfsdk:"oauth2"`
+	Traits            types.List   `tfsdk:"traits"`
+	DependenciesCount types.Int64  `tfsdk:"dependencies_count"`
+}
+
+// ApplicationResourceModel describes the resource data model. It extends the shared
+// application model with the dependencies managed inline by the resource.
+type ApplicationResourceModel struct {
+	ApplicationModel
+
+	Dependencies types.Set `tfsdk:"dependencies"`
 }
 
 // ApplicationMetadataModel describes the nested metadata block data model.
@@ -86,6 +102,31 @@ func (r *ApplicationResource) Schema(ctx context.Context, req resource.SchemaReq
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"slug": schema.StringAttribute{
+				MarkdownDescription: "URL-safe identifier of the application, unique within the zone.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "The organization that owns the application.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The time the application was created, in RFC 3339 format.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "The time the application was last updated, in RFC 3339 format.",
+				Computed:            true,
+			},
 			"zone_id": schema.StringAttribute{
 				MarkdownDescription: "The zone this application belongs to. Changing this will replace the application.",
 				Required:            true,
@@ -142,6 +183,23 @@ func (r *ApplicationResource) Schema(ctx context.Context, req resource.SchemaReq
 					),
 				},
 			},
+			"dependencies": schema.SetAttribute{
+				MarkdownDescription: "IDs of the resources the application depends on. Dependencies are created together with the application and added or removed in place afterwards. " +
+					"Only the listed dependencies are managed: other dependencies of the application, such as those created in the console or by `keycard_application_dependency`, are left untouched, " +
+					"and listing a dependency that already exists adopts it without changing its `when_accessing`. Removing the attribute removes the dependencies it managed. " +
+					"Do not list resources whose dependency is also managed by a `keycard_application_dependency` resource.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(
+						stringvalidator.LengthAtLeast(1),
+					),
+				},
+			},
+			"dependencies_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of resources the application depends on, including dependencies managed outside of this resource.",
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -167,7 +225,7 @@ func (r *ApplicationResource) Configure(ctx context.Context, req resource.Config
 }
 
 func (r *ApplicationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data ApplicationModel
+	var data ApplicationResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -246,6 +304,25 @@ func (r *ApplicationResource) Create(ctx context.Context, req resource.CreateReq
 		createReq.Traits = &applicationTraits
 	}
 
+	// Set dependencies if provided
+	if !data.Dependencies.IsNull() && !data.Dependencies.IsUnknown() {
+		var dependencyIDs []string
+		diags := data.Dependencies.ElementsAs(ctx, &dependencyIDs, false)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		dependencies := make([]struct {
+			Id   string  `json:"id"`
+			Type *string `json:"type,omitempty"`
+		}, len(dependencyIDs))
+		for i, id := range dependencyIDs {
+			dependencies[i].Id = id
+		}
+		createReq.Dependencies = &dependencies
+	}
+
 	// Create the application
 	createResp, err := r.client.CreateApplicationWithResponse(ctx, data.ZoneID.ValueString(), createReq)
 	if err != nil {
@@ -267,7 +344,7 @@ func (r *ApplicationResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	// Update the model with the response data
-	resp.Diagnostics.Append(updateApplicationModelFromAPIResponse(ctx, createResp.JSON200, &data)...)
+	resp.Diagnostics.Append(updateApplicationModelFromAPIResponse(ctx, createResp.JSON200, &data.ApplicationModel)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -277,7 +354,7 @@ func (r *ApplicationResource) Create(ctx context.Context, req resource.CreateReq
 }
 
 func (r *ApplicationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data ApplicationModel
+	var data ApplicationResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -313,20 +390,31 @@ func (r *ApplicationResource) Read(ctx context.Context, req resource.ReadRequest
 	}
 
 	// Update the model with the response data
-	resp.Diagnostics.Append(updateApplicationModelFromAPIResponse(ctx, getResp.JSON200, &data)...)
+	resp.Diagnostics.Append(updateApplicationModelFromAPIResponse(ctx, getResp.JSON200, &data.ApplicationModel)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// Refresh the dependencies only when they are managed by this resource
+	if !data.Dependencies.IsNull() {
+		dependencies, diags := r.readDependencies(ctx, data.ZoneID.ValueString(), data.ID.ValueString(), data.Dependencies)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.Dependencies = dependencies
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *ApplicationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data ApplicationModel
+	var data, state ApplicationResourceModel
 
-	// Read Terraform plan data into the model
+	// Read Terraform plan and prior state data into the models
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -437,6 +525,15 @@ func (r *ApplicationResource) Update(ctx context.Context, req resource.UpdateReq
 		}
 	}
 
+	// Reconcile the dependencies first, so the updated application reports the new dependency count.
+	// Removing the attribute removes the dependencies it managed, like an empty set.
+	if !data.Dependencies.IsUnknown() && (!data.Dependencies.IsNull() || !state.Dependencies.IsNull()) {
+		resp.Diagnostics.Append(r.reconcileDependencies(ctx, data.ZoneID.ValueString(), data.ID.ValueString(), data.Dependencies, state.Dependencies)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	// Update the application
 	updateResp, err := r.client.UpdateApplicationWithResponse(ctx, data.ZoneID.ValueString(), data.ID.ValueString(), updateReq)
 	if err != nil {
@@ -458,7 +555,7 @@ func (r *ApplicationResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 
 	// Update the model with the response data
-	resp.Diagnostics.Append(updateApplicationModelFromAPIResponse(ctx, updateResp.JSON200, &data)...)
+	resp.Diagnostics.Append(updateApplicationModelFromAPIResponse(ctx, updateResp.JSON200, &data.ApplicationModel)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -468,7 +565,7 @@ func (r *ApplicationResource) Update(ctx context.Context, req resource.UpdateReq
 }
 
 func (r *ApplicationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data ApplicationModel
+	var data ApplicationResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -510,3 +607,140 @@ func (r *ApplicationResource) ImportState(ctx context.Context, req resource.Impo
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone_id"), zoneID)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), applicationID)...)
 }
+
+// readDependencies returns the managed dependencies that the application still depends on.
+// Dependencies created outside of this resource are not included.
+func (r *ApplicationResource) readDependencies(ctx context.Context, zoneID, applicationID string, managed types.Set) (types.Set, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var managedIDs []string
+	diags.Append(managed.ElementsAs(ctx, &managedIDs, false)...)
+	if diags.HasError() {
+		return types.SetNull(types.StringType), diags
+	}
+
+	dependencyIDs, listDiags := r.listDependencyIDs(ctx, zoneID, applicationID)
+	diags.Append(listDiags...)
+	if diags.HasError() {
+		return types.SetNull(types.StringType), diags
+	}
+
+	remainingIDs := []string{}
+	for _, resourceID := range managedIDs {
+		if slices.Contains(dependencyIDs, resourceID) {
+			remainingIDs = append(remainingIDs, resourceID)
+		}
+	}
+
+	dependencies, setDiags := types.SetValueFrom(ctx, types.StringType, remainingIDs)
+	diags.Append(setDiags...)
+
+	return dependencies, diags
+}
+
+// listDependencyIDs lists the IDs of every resource the application depends on.
+func (r *ApplicationResource) listDependencyIDs(ctx context.Context, zoneID, applicationID string) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Follow the page cursor until every dependency has been listed
+	dependencyIDs := []string{}
+	params := client.ListApplicationDependenciesParams{}
+	for {
+		listResp, err := r.client.ListApplicationDependenciesWithResponse(ctx, zoneID, applicationID, &params)
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to list application dependencies, got error: %s", err))
+			return nil, diags
+		}
+
+		if listResp.StatusCode() != 200 {
+			diags.AddError(
+				"API Error",
+				fmt.Sprintf("Unable to list application dependencies, got status %d: %s", listResp.StatusCode(), string(listResp.Body)),
+			)
+			return nil, diags
+		}
+
+		if listResp.JSON200 == nil {
+			diags.AddError("API Error", "Unable to list application dependencies, no response body")
+			return nil, diags
+		}
+
+		for _, dependency := range listResp.JSON200.Items {
+			dependencyIDs = append(dependencyIDs, dependency.Id)
+		}
+
+		params.Cursor = nextPageCursor(listResp.JSON200.PageInfo)
+		if params.Cursor == nil {
+			break
+		}
+	}
+
+	return dependencyIDs, diags
+}
+
+// reconcileDependencies adds the planned dependencies missing from the prior state and removes
+// the prior dependencies that are no longer planned. Planned dependencies that already exist are
+// adopted as they are, so their when_accessing is kept.
+func (r *ApplicationResource) reconcileDependencies(ctx context.Context, zoneID, applicationID string, planned, prior types.Set) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var plannedIDs, priorIDs []string
+	if !planned.IsNull() {
+		diags.Append(planned.ElementsAs(ctx, &plannedIDs, false)...)
+	}
+	if !prior.IsNull() && !prior.IsUnknown() {
+		diags.Append(prior.ElementsAs(ctx, &priorIDs, false)...)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	existingIDs, listDiags := r.listDependencyIDs(ctx, zoneID, applicationID)
+	diags.Append(listDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	for _, resourceID := range plannedIDs {
+		if slices.Contains(priorIDs, resourceID) || slices.Contains(existingIDs, resourceID) {
+			continue
+		}
+
+		addResp, err := r.client.AddApplicationDependencyWithResponse(ctx, zoneID, applicationID, resourceID, nil)
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to add application dependency %s, got error: %s", resourceID, err))
+			return diags
+		}
+
+		if addResp.StatusCode() != 204 {
+			diags.AddError(
+				"API Error",
+				fmt.Sprintf("Unable to add application dependency %s, got status %d: %s", resourceID, addResp.StatusCode(), string(addResp.Body)),
+			)
+			return diags
+		}
+	}
+
+	for _, resourceID := range priorIDs {
+		if slices.Contains(plannedIDs, resourceID) {
+			continue
+		}
+
+		removeResp, err := r.client.RemoveApplicationDependencyWithResponse(ctx, zoneID, applicationID, resourceID)
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to remove application dependency %s, got error: %s", resourceID, err))
+			return diags
+		}
+
+		// Accept both 204 (removed) and 404 (already gone) as success
+		if removeResp.StatusCode() != 204 && removeResp.StatusCode() != 404 {
+			diags.AddError(
+				"API Error",
+				fmt.Sprintf("Unable to remove application dependency %s, got status %d: %s", resourceID, removeResp.StatusCode(), string(removeResp.Body)),
+			)
+			return diags
+		}
+	}
+
+	return diags
+}
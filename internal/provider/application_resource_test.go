@@ -3,10 +3,12 @@ package provider
 import (
 	"fmt"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
@@ -25,6 +27,10 @@ func TestAccApplicationResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("keycard_application.test", "name", rName),
 					resource.TestCheckResourceAttr("keycard_application.test", "identifier", "https://"+rName+".example.com"),
 					resource.TestCheckResourceAttrSet("keycard_application.test", "id"),
+					resource.TestCheckResourceAttrSet("keycard_application.test", "slug"),
+					resource.TestCheckResourceAttrSet("keycard_application.test", "organization_id"),
+					resource.TestCheckResourceAttrSet("keycard_application.test", "created_at"),
+					resource.TestCheckResourceAttrSet("keycard_application.test", "updated_at"),
 					resource.TestCheckResourceAttrSet("keycard_application.test", "zone_id"),
 				),
 			},
@@ -473,3 +479,229 @@ resource "keycard_application" "test" {
 }
 `, zoneName, appName)
 }
+
+func TestAccApplicationResource_withDependencies(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tftest")
+	zoneName := acctest.RandomWithPrefix("tftest-zone")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with dependencies sent in the create request
+			{
+				Config: testAccApplicationResourceConfig_withDependencies(zoneName, rName, []string{"first", "second"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("keycard_application.test", "dependencies.#", "2"),
+					resource.TestCheckResourceAttr("keycard_application.test", "dependencies_count", "2"),
+					resource.TestCheckTypeSetElemAttrPair(
+						"keycard_application.test", "dependencies.*",
+						"keycard_resource.first", "id",
+					),
+					resource.TestCheckTypeSetElemAttrPair(
+						"keycard_application.test", "dependencies.*",
+						"keycard_resource.second", "id",
+					),
+				),
+			},
+			// Dependencies are not tracked after import until they are configured again
+			{
+				ResourceName:            "keycard_application.test",
+				ImportState:             true,
+				ImportStateIdFunc:       testAccApplicationImportStateIdFunc("keycard_application.test"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"dependencies"},
+			},
+			// Replace a dependency in place
+			{
+				Config: testAccApplicationResourceConfig_withDependencies(zoneName, rName, []string{"second", "third"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("keycard_application.test", "dependencies.#", "2"),
+					resource.TestCheckResourceAttr("keycard_application.test", "dependencies_count", "2"),
+					resource.TestCheckTypeSetElemAttrPair(
+						"keycard_application.test", "dependencies.*",
+						"keycard_resource.second", "id",
+					),
+					resource.TestCheckTypeSetElemAttrPair(
+						"keycard_application.test", "dependencies.*",
+						"keycard_resource.third", "id",
+					),
+				),
+			},
+			// Remove every dependency
+			{
+				Config: testAccApplicationResourceConfig_withDependencies(zoneName, rName, []string{}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("keycard_application.test", "dependencies.#", "0"),
+					resource.TestCheckResourceAttr("keycard_application.test", "dependencies_count", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccApplicationResource_dependenciesInPlace(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tftest")
+	zoneName := acctest.RandomWithPrefix("tftest-zone")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with one managed dependency, next to one managed by keycard_application_dependency
+			{
+				Config: testAccApplicationResourceConfig_withExternalDependency(zoneName, rName, []string{"first"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("keycard_application.test", "dependencies.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(
+						"keycard_application.test", "dependencies.*",
+						"keycard_resource.first", "id",
+					),
+				),
+			},
+			// The dependency created outside of the attribute is not read into it
+			{
+				Config: testAccApplicationResourceConfig_withExternalDependency(zoneName, rName, []string{"first"}),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("keycard_application.test", "dependencies.#", "1"),
+					resource.TestCheckResourceAttr("keycard_application.test", "dependencies_count", "2"),
+				),
+			},
+			// Add a dependency in place
+			{
+				Config: testAccApplicationResourceConfig_withExternalDependency(zoneName, rName, []string{"first", "third"}),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("keycard_application.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("keycard_application.test", "dependencies.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(
+						"keycard_application.test", "dependencies.*",
+						"keycard_resource.third", "id",
+					),
+				),
+			},
+			// Remove the added dependency in place
+			{
+				Config: testAccApplicationResourceConfig_withExternalDependency(zoneName, rName, []string{"first"}),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("keycard_application.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("keycard_application.test", "dependencies.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(
+						"keycard_application.test", "dependencies.*",
+						"keycard_resource.first", "id",
+					),
+				),
+			},
+			// Removing the attribute removes the managed dependency and keeps the other one
+			{
+				Config: testAccApplicationResourceConfig_withExternalDependency(zoneName, rName, nil),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("keycard_application.test", "dependencies.#"),
+					resource.TestCheckResourceAttr("keycard_application.test", "dependencies_count", "1"),
+				),
+			},
+			// The other dependency keeps its when_accessing
+			{
+				Config: testAccApplicationResourceConfig_withExternalDependency(zoneName, rName, nil),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("keycard_application_dependency.second", "when_accessing.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(
+						"keycard_application_dependency.second", "when_accessing.*",
+						"keycard_resource.first", "id",
+					),
+				),
+			},
+		},
+	})
+}
+
+// testAccApplicationResourceConfig_withExternalDependency manages the given dependencies inline, and
+// the dependency on the second resource through keycard_application_dependency. A nil list leaves
+// the dependencies attribute unset.
+func testAccApplicationResourceConfig_withExternalDependency(zoneName, appName string, dependencies []string) string {
+	config := testAccApplicationResourceConfig_withDependencies(zoneName, appName, dependencies)
+	if dependencies == nil {
+		config = strings.Replace(config, "\n  dependencies = [\n\n  ]\n", "", 1)
+	}
+
+	return config + `
+resource "keycard_application_dependency" "second" {
+  zone_id        = keycard_zone.test.id
+  application_id = keycard_application.test.id
+  resource_id    = keycard_resource.second.id
+  when_accessing = [keycard_resource.first.id]
+}
+`
+}
+
+func testAccApplicationResourceConfig_withDependencies(zoneName, appName string, dependencies []string) string {
+	config := fmt.Sprintf(`
+resource "keycard_zone" "test" {
+  name = %[1]q
+}
+
+resource "keycard_provider" "test" {
+  name       = "%[2]s-provider"
+  identifier = "https://%[2]s-provider.example.com"
+  zone_id    = keycard_zone.test.id
+}
+
+resource "keycard_resource" "first" {
+  name                   = "%[2]s-first"
+  identifier             = "https://%[2]s-first.example.com"
+  zone_id                = keycard_zone.test.id
+  credential_provider_id = keycard_provider.test.id
+}
+
+resource "keycard_resource" "second" {
+  name                   = "%[2]s-second"
+  identifier             = "https://%[2]s-second.example.com"
+  zone_id                = keycard_zone.test.id
+  credential_provider_id = keycard_provider.test.id
+}
+
+resource "keycard_resource" "third" {
+  name                   = "%[2]s-third"
+  identifier             = "https://%[2]s-third.example.com"
+  zone_id                = keycard_zone.test.id
+  credential_provider_id = keycard_provider.test.id
+}
+
+resource "keycard_application" "test" {
+  name       = %[2]q
+  identifier = "https://%[2]s.example.com"
+  zone_id    = keycard_zone.test.id
+
+  dependencies = [
+`, zoneName, appName)
+
+	for i, dependency := range dependencies {
+		if i > 0 {
+			config += ",\n"
+		}
+		config += fmt.Sprintf("    keycard_resource.%s.id", dependency)
+	}
+
+	config += `
+  ]
+}
+`
+	return config
+}
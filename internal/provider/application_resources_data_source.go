@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/keycardai/terraform-provider-keycard/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ApplicationResourcesDataSource{}
+
+func NewApplicationResourcesDataSource() datasource.DataSource {
+	return &ApplicationResourcesDataSource{}
+}
+
+// ApplicationResourcesDataSource defines the data source implementation.
+type ApplicationResourcesDataSource struct {
+	client *client.ClientWithResponses
+}
+
+// ApplicationResourcesDataSourceModel describes the data source data model.
+type ApplicationResourcesDataSourceModel struct {
+	ZoneID        types.String    `tfsdk:"zone_id"`
+	ApplicationID types.String    `tfsdk:"application_id"`
+	Resources     []ResourceModel `tfsdk:"resources"`
+}
+
+func (d *ApplicationResourcesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application_resources"
+}
+
+func (d *ApplicationResourcesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the Keycard resources provided by an application, such as the resources exposed by an MCP gateway.",
+
+		Attributes: map[string]schema.Attribute{
+			"zone_id": schema.StringAttribute{
+				MarkdownDescription: "The zone the application belongs to.",
+				Required:            true,
+			},
+			"application_id": schema.StringAttribute{
+				MarkdownDescription: "The application to list provided resources for.",
+				Required:            true,
+			},
+			"resources": schema.ListNestedAttribute{
+				MarkdownDescription: "The resources provided by the application.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: resourceDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *ApplicationResourcesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ClientWithResponses)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ApplicationResourcesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ApplicationResourcesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := client.ListApplicationResourcesParams{}
+
+	// Follow the page cursor until every resource has been listed
+	data.Resources = []ResourceModel{}
+	for {
+		listResp, err := d.client.ListApplicationResourcesWithResponse(ctx, data.ZoneID.ValueString(), data.ApplicationID.ValueString(), &params)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list application resources, got error: %s", err))
+			return
+		}
+
+		if listResp.StatusCode() != 200 {
+			resp.Diagnostics.AddError(
+				"API Error",
+				fmt.Sprintf("Unable to list application resources, got status %d: %s", listResp.StatusCode(), string(listResp.Body)),
+			)
+			return
+		}
+
+		if listResp.JSON200 == nil {
+			resp.Diagnostics.AddError("API Error", "Unable to list application resources, no response body")
+			return
+		}
+
+		for _, resource := range listResp.JSON200.Items {
+			var resourceData ResourceModel
+			resp.Diagnostics.Append(updateResourceModelFromAPIResponse(ctx, &resource, &resourceData)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			data.Resources = append(data.Resources, resourceData)
+		}
+
+		params.Cursor = nextPageCursor(listResp.JSON200.PageInfo)
+		if params.Cursor == nil {
+			break
+		}
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
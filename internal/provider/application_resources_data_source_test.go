@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccApplicationResourcesDataSource_basic(t *testing.T) {
+	zoneName := acctest.RandomWithPrefix("tftest-zone")
+	rName := acctest.RandomWithPrefix("tftest")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Only the resources provided by the gateway application are returned
+			{
+				Config: testAccApplicationResourcesDataSourceConfig(zoneName, rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.keycard_application_resources.test", "resources.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(
+						"data.keycard_application_resources.test", "resources.*.id",
+						"keycard_resource.tools", "id",
+					),
+					resource.TestCheckTypeSetElemAttrPair(
+						"data.keycard_application_resources.test", "resources.*.id",
+						"keycard_resource.prompts", "id",
+					),
+					resource.TestCheckTypeSetElemAttrPair(
+						"data.keycard_application_resources.test", "resources.*.application_id",
+						"keycard_application.gateway", "id",
+					),
+					resource.TestCheckTypeSetElemNestedAttrs("data.keycard_application_resources.test", "resources.*", map[string]string{
+						"identifier": fmt.Sprintf("https://%s-tools.example.com", rName),
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccApplicationResourcesDataSourceConfig(zoneName, name string) string {
+	return fmt.Sprintf(`
+resource "keycard_zone" "test" {
+  name = %[1]q
+}
+
+resource "keycard_provider" "test" {
+  name       = %[2]q
+  identifier = "https://%[2]s.example.com"
+  zone_id    = keycard_zone.test.id
+}
+
+resource "keycard_application" "gateway" {
+  name       = "%[2]s-gateway"
+  identifier = "https://%[2]s-gateway.example.com"
+  zone_id    = keycard_zone.test.id
+}
+
+resource "keycard_resource" "tools" {
+  name                   = "%[2]s-tools"
+  identifier             = "https://%[2]s-tools.example.com"
+  zone_id                = keycard_zone.test.id
+  credential_provider_id = keycard_provider.test.id
+  application_id         = keycard_application.gateway.id
+}
+
+resource "keycard_resource" "prompts" {
+  name                   = "%[2]s-prompts"
+  identifier             = "https://%[2]s-prompts.example.com"
+  zone_id                = keycard_zone.test.id
+  credential_provider_id = keycard_provider.test.id
+  application_id         = keycard_application.gateway.id
+}
+
+# Not provided by the gateway, so it must not be returned
+resource "keycard_resource" "unrelated" {
+  name                   = "%[2]s-unrelated"
+  identifier             = "https://%[2]s-unrelated.example.com"
+  zone_id                = keycard_zone.test.id
+  credential_provider_id = keycard_provider.test.id
+}
+
+data "keycard_application_resources" "test" {
+  zone_id        = keycard_zone.test.id
+  application_id = keycard_application.gateway.id
+
+  depends_on = [keycard_resource.tools, keycard_resource.prompts, keycard_resource.unrelated]
+}
+`, zoneName, name)
+}
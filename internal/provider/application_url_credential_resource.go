@@ -31,10 +31,14 @@ type ApplicationURLCredentialResource struct {
 
 // ApplicationURLCredentialModel describes the application URL credential data model.
 type ApplicationURLCredentialModel struct {
-	ID            types.String `tfsdk:"id"`
-	ZoneID        types.String `tfsdk:"zone_id"`
-	ApplicationID types.String `tfsdk:"application_id"`
-	URL           types.String `tfsdk:"url"`
+	ID             types.String `tfsdk:"id"`
+	ZoneID         types.String `tfsdk:"zone_id"`
+	Slug           types.String `tfsdk:"slug"`
+	OrganizationID types.String `tfsdk:"organization_id"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+	UpdatedAt      types.String `tfsdk:"updated_at"`
+	ApplicationID  types.String `tfsdk:"application_id"`
+	URL            types.String `tfsdk:"url"`
 }
 
 func (r *ApplicationURLCredentialResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -54,6 +58,31 @@ func (r *ApplicationURLCredentialResource) Schema(ctx context.Context, req resou
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"slug": schema.StringAttribute{
+				MarkdownDescription: "URL-safe identifier of the credential, unique within the zone.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "The organization that owns the credential.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The time the credential was created, in RFC 3339 format.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "The time the credential was last updated, in RFC 3339 format.",
+				Computed:            true,
+			},
 			"zone_id": schema.StringAttribute{
 				MarkdownDescription: "The zone this credential belongs to. Changing this will replace the credential.",
 				Required:            true,
@@ -69,11 +98,8 @@ func (r *ApplicationURLCredentialResource) Schema(ctx context.Context, req resou
 				},
 			},
 			"url": schema.StringAttribute{
-				MarkdownDescription: "The URL credential value. Must be a valid URL. Changing this will replace the credential.",
+				MarkdownDescription: "The URL credential value. Must be a valid URL.",
 				Required:            true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
 			},
 		},
 	}
@@ -200,12 +226,57 @@ func (r *ApplicationURLCredentialResource) Read(ctx context.Context, req resourc
 }
 
 func (r *ApplicationURLCredentialResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// This resource does not support updates - all attributes require replacement
-	// This method should never be called due to RequiresReplace plan modifiers
-	resp.Diagnostics.AddError(
-		"Update Not Supported",
-		"Application URL credentials are immutable. Any changes require resource replacement.",
-	)
+	var data ApplicationURLCredentialModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build the update request for url credential
+	urlUpdateType := client.Url
+	urlUpdate := client.UrlCredentialUpdate{
+		Type:       &urlUpdateType,
+		Identifier: data.URL.ValueStringPointer(),
+	}
+
+	updateReq := client.ApplicationCredentialUpdate{}
+	err := updateReq.FromUrlCredentialUpdate(urlUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to construct application URL credential update request body, got error: %s", err))
+		return
+	}
+
+	// Update the credential
+	updateResp, err := r.client.UpdateApplicationCredentialWithResponse(ctx, data.ZoneID.ValueString(), data.ID.ValueString(), updateReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update application URL credential, got error: %s", err))
+		return
+	}
+
+	if updateResp.StatusCode() != 200 {
+		resp.Diagnostics.AddError(
+			"API Error",
+			fmt.Sprintf("Unable to update application URL credential, got status %d: %s", updateResp.StatusCode(), string(updateResp.Body)),
+		)
+		return
+	}
+
+	if updateResp.JSON200 == nil {
+		resp.Diagnostics.AddError("API Error", "Unable to update application URL credential, no response body")
+		return
+	}
+
+	// Update the model with the response data
+	resp.Diagnostics.Append(updateApplicationURLCredentialModelFromAPIResponse(updateResp.JSON200, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *ApplicationURLCredentialResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
@@ -23,6 +24,10 @@ func TestAccApplicationURLCredentialResource_basic(t *testing.T) {
 				Config: testAccApplicationURLCredentialResourceConfig_basic(zoneName, rName, urlValue),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("keycard_application_url_credential.test", "id"),
+					resource.TestCheckResourceAttrSet("keycard_application_url_credential.test", "slug"),
+					resource.TestCheckResourceAttrSet("keycard_application_url_credential.test", "organization_id"),
+					resource.TestCheckResourceAttrSet("keycard_application_url_credential.test", "created_at"),
+					resource.TestCheckResourceAttrSet("keycard_application_url_credential.test", "updated_at"),
 					resource.TestCheckResourceAttrSet("keycard_application_url_credential.test", "zone_id"),
 					resource.TestCheckResourceAttrSet("keycard_application_url_credential.test", "application_id"),
 					resource.TestCheckResourceAttr("keycard_application_url_credential.test", "url", urlValue),
@@ -62,6 +67,7 @@ func TestAccApplicationURLCredentialResource_urlChange(t *testing.T) {
 	zoneName := acctest.RandomWithPrefix("tftest-zone")
 	urlValue1 := fmt.Sprintf("https://%s-1.example.com", rName)
 	urlValue2 := fmt.Sprintf("https://%s-2.example.com", rName)
+	var credentialID string
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -71,15 +77,28 @@ func TestAccApplicationURLCredentialResource_urlChange(t *testing.T) {
 			{
 				Config: testAccApplicationURLCredentialResourceConfig_basic(zoneName, rName, urlValue1),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttrSet("keycard_application_url_credential.test", "id"),
+					resource.TestCheckResourceAttrWith("keycard_application_url_credential.test", "id", func(value string) error {
+						credentialID = value
+						return nil
+					}),
 					resource.TestCheckResourceAttr("keycard_application_url_credential.test", "url", urlValue1),
 				),
 			},
-			// Change URL (should force replacement)
+			// Change URL (should update in place)
 			{
 				Config: testAccApplicationURLCredentialResourceConfig_basic(zoneName, rName, urlValue2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("keycard_application_url_credential.test", plancheck.ResourceActionUpdate),
+					},
+				},
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttrSet("keycard_application_url_credential.test", "id"),
+					resource.TestCheckResourceAttrWith("keycard_application_url_credential.test", "id", func(value string) error {
+						if value != credentialID {
+							return fmt.Errorf("expected credential %s to be updated in place, got %s", credentialID, value)
+						}
+						return nil
+					}),
 					resource.TestCheckResourceAttr("keycard_application_url_credential.test", "url", urlValue2),
 				),
 			},
@@ -36,6 +36,22 @@ func (d *ApplicationWorkloadIdentityDataSource) Schema(ctx context.Context, req
 				MarkdownDescription: "Unique identifier of the workload identity credential.",
 				Required:            true,
 			},
+			"slug": schema.StringAttribute{
+				MarkdownDescription: "URL-safe identifier of the credential, unique within the zone.",
+				Computed:            true,
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "The organization that owns the credential.",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The time the credential was created, in RFC 3339 format.",
+				Computed:            true,
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "The time the credential was last updated, in RFC 3339 format.",
+				Computed:            true,
+			},
 			"zone_id": schema.StringAttribute{
 				MarkdownDescription: "The zone this credential belongs to.",
 				Required:            true,
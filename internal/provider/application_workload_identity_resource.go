@@ -33,11 +33,15 @@ type ApplicationWorkloadIdentityResource struct {
 
 // ApplicationWorkloadIdentityModel describes the application workload identity data model.
 type ApplicationWorkloadIdentityModel struct {
-	ID            types.String `tfsdk:"id"`
-	ZoneID        types.String `tfsdk:"zone_id"`
-	ApplicationID types.String `tfsdk:"application_id"`
-	ProviderID    types.String `tfsdk:"provider_id"`
-	Subject       types.String `tfsdk:"subject"`
+	ID             types.String `tfsdk:"id"`
+	ZoneID         types.String `tfsdk:"zone_id"`
+	Slug           types.String `tfsdk:"slug"`
+	OrganizationID types.String `tfsdk:"organization_id"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+	UpdatedAt      types.String `tfsdk:"updated_at"`
+	ApplicationID  types.String `tfsdk:"application_id"`
+	ProviderID     types.String `tfsdk:"provider_id"`
+	Subject        types.String `tfsdk:"subject"`
 }
 
 func (r *ApplicationWorkloadIdentityResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -58,6 +62,31 @@ func (r *ApplicationWorkloadIdentityResource) Schema(ctx context.Context, req re
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"slug": schema.StringAttribute{
+				MarkdownDescription: "URL-safe identifier of the credential, unique within the zone.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "The organization that owns the credential.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The time the credential was created, in RFC 3339 format.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "The time the credential was last updated, in RFC 3339 format.",
+				Computed:            true,
+			},
 			"zone_id": schema.StringAttribute{
 				MarkdownDescription: "The zone this credential belongs to. Changing this will replace the credential.",
 				Required:            true,
@@ -25,6 +25,10 @@ func TestAccApplicationWorkloadIdentityResource_basic(t *testing.T) {
 				Config: testAccApplicationWorkloadIdentityResourceConfig_kubernetes(zoneName, rName, namespace, serviceAccount),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("keycard_application_workload_identity.test", "id"),
+					resource.TestCheckResourceAttrSet("keycard_application_workload_identity.test", "slug"),
+					resource.TestCheckResourceAttrSet("keycard_application_workload_identity.test", "organization_id"),
+					resource.TestCheckResourceAttrSet("keycard_application_workload_identity.test", "created_at"),
+					resource.TestCheckResourceAttrSet("keycard_application_workload_identity.test", "updated_at"),
 					resource.TestCheckResourceAttrSet("keycard_application_workload_identity.test", "zone_id"),
 					resource.TestCheckResourceAttrSet("keycard_application_workload_identity.test", "application_id"),
 					resource.TestCheckResourceAttrSet("keycard_application_workload_identity.test", "provider_id"),
@@ -0,0 +1,227 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/keycardai/terraform-provider-keycard/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ApplicationsDataSource{}
+
+func NewApplicationsDataSource() datasource.DataSource {
+	return &ApplicationsDataSource{}
+}
+
+// ApplicationsDataSource defines the data source implementation.
+type ApplicationsDataSource struct {
+	client *client.ClientWithResponses
+}
+
+// ApplicationsDataSourceModel describes the data source data model.
+type ApplicationsDataSourceModel struct {
+	ZoneID       types.String       `tfsdk:"zone_id"`
+	Slug         types.String       `tfsdk:"slug"`
+	Identifier   types.String       `tfsdk:"identifier"`
+	Traits       types.Set          `tfsdk:"traits"`
+	NameRegex    types.String       `tfsdk:"name_regex"`
+	Applications []ApplicationModel `tfsdk:"applications"`
+}
+
+func (d *ApplicationsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_applications"
+}
+
+func (d *ApplicationsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the Keycard applications in a zone, optionally filtered by slug, identifier, traits or name.",
+
+		Attributes: map[string]schema.Attribute{
+			"zone_id": schema.StringAttribute{
+				MarkdownDescription: "The zone to list applications from.",
+				Required:            true,
+			},
+			"slug": schema.StringAttribute{
+				MarkdownDescription: "Only return the application with this slug.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"identifier": schema.StringAttribute{
+				MarkdownDescription: "Only return the application with this identifier.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"traits": schema.SetAttribute{
+				MarkdownDescription: "Only return applications that have all of these traits, e.g. `gateway`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only return applications whose name matches this regular expression, using [RE2 syntax](https://github.com/google/re2/wiki/Syntax).",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"applications": schema.ListNestedAttribute{
+				MarkdownDescription: "The applications matching the filters.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: applicationDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *ApplicationsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ClientWithResponses)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ApplicationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ApplicationsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Client-side filters
+	var nameRegex *regexp.Regexp
+	if !data.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(data.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid Name Regex",
+				fmt.Sprintf("Unable to compile name_regex %q: %s", data.NameRegex.ValueString(), err),
+			)
+			return
+		}
+	}
+
+	var traits []string
+	if !data.Traits.IsNull() {
+		resp.Diagnostics.Append(data.Traits.ElementsAs(ctx, &traits, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Server-side filters
+	params := client.ListApplicationsParams{}
+	if !data.Slug.IsNull() {
+		params.Slug = data.Slug.ValueStringPointer()
+	}
+	if !data.Identifier.IsNull() {
+		params.Identifier = data.Identifier.ValueStringPointer()
+	}
+
+	// Follow the page cursor until every application has been listed
+	data.Applications = []ApplicationModel{}
+	for {
+		listResp, err := d.client.ListApplicationsWithResponse(ctx, data.ZoneID.ValueString(), &params)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list applications, got error: %s", err))
+			return
+		}
+
+		if listResp.StatusCode() != 200 {
+			resp.Diagnostics.AddError(
+				"API Error",
+				fmt.Sprintf("Unable to list applications, got status %d: %s", listResp.StatusCode(), string(listResp.Body)),
+			)
+			return
+		}
+
+		if listResp.JSON200 == nil {
+			resp.Diagnostics.AddError("API Error", "Unable to list applications, no response body")
+			return
+		}
+
+		for _, application := range listResp.JSON200.Items {
+			if nameRegex != nil && !nameRegex.MatchString(application.Name) {
+				continue
+			}
+
+			if !applicationHasTraits(&application, traits) {
+				continue
+			}
+
+			var applicationData ApplicationModel
+			resp.Diagnostics.Append(updateApplicationModelFromAPIResponse(ctx, &application, &applicationData)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			data.Applications = append(data.Applications, applicationData)
+		}
+
+		params.Cursor = nextPageCursor(listResp.JSON200.PageInfo)
+		if params.Cursor == nil {
+			break
+		}
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// applicationHasTraits reports whether the application has every one of the given traits.
+func applicationHasTraits(application *client.Application, traits []string) bool {
+	if len(traits) == 0 {
+		return true
+	}
+
+	applicationTraits, err := application.Traits.Get()
+	if err != nil {
+		return false
+	}
+
+	present := make(map[string]bool, len(applicationTraits))
+	for _, trait := range applicationTraits {
+		present[string(trait)] = true
+	}
+
+	for _, trait := range traits {
+		if !present[trait] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccApplicationsDataSource_basic(t *testing.T) {
+	zoneName := acctest.RandomWithPrefix("tftest-zone")
+	rName := acctest.RandomWithPrefix("tftest")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// List every application in the zone
+			{
+				Config: testAccApplicationsDataSourceConfig(zoneName, rName, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.keycard_applications.test", "applications.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(
+						"data.keycard_applications.test", "applications.*.id",
+						"keycard_application.gateway", "id",
+					),
+					resource.TestCheckTypeSetElemAttrPair(
+						"data.keycard_applications.test", "applications.*.id",
+						"keycard_application.backend", "id",
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestAccApplicationsDataSource_filters(t *testing.T) {
+	zoneName := acctest.RandomWithPrefix("tftest-zone")
+	rName := acctest.RandomWithPrefix("tftest")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Filter by trait
+			{
+				Config: testAccApplicationsDataSourceConfig(zoneName, rName, `traits = ["gateway"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.keycard_applications.test", "applications.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.keycard_applications.test", "applications.0.id",
+						"keycard_application.gateway", "id",
+					),
+					resource.TestCheckResourceAttr("data.keycard_applications.test", "applications.0.traits.0", "gateway"),
+				),
+			},
+			// Filter by name regex
+			{
+				Config: testAccApplicationsDataSourceConfig(zoneName, rName, `name_regex = "-backend$"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.keycard_applications.test", "applications.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.keycard_applications.test", "applications.0.id",
+						"keycard_application.backend", "id",
+					),
+				),
+			},
+			// Filter by identifier
+			{
+				Config: testAccApplicationsDataSourceConfig(zoneName, rName, `identifier = keycard_application.gateway.identifier`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.keycard_applications.test", "applications.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.keycard_applications.test", "applications.0.identifier",
+						"keycard_application.gateway", "identifier",
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestAccApplicationsDataSource_invalidNameRegex(t *testing.T) {
+	zoneName := acctest.RandomWithPrefix("tftest-zone")
+	rName := acctest.RandomWithPrefix("tftest")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccApplicationsDataSourceConfig(zoneName, rName, `name_regex = "("`),
+				ExpectError: regexp.MustCompile(`Invalid Name Regex`),
+			},
+		},
+	})
+}
+
+func testAccApplicationsDataSourceConfig(zoneName, appName, filters string) string {
+	return fmt.Sprintf(`
+resource "keycard_zone" "test" {
+  name = %[1]q
+}
+
+resource "keycard_application" "gateway" {
+  name       = "%[2]s-gateway"
+  identifier = "https://%[2]s-gateway.example.com"
+  zone_id    = keycard_zone.test.id
+  traits     = ["gateway"]
+}
+
+resource "keycard_application" "backend" {
+  name       = "%[2]s-backend"
+  identifier = "https://%[2]s-backend.example.com"
+  zone_id    = keycard_zone.test.id
+}
+
+data "keycard_applications" "test" {
+  zone_id = keycard_zone.test.id
+  %[3]s
+
+  depends_on = [keycard_application.gateway, keycard_application.backend]
+}
+`, zoneName, appName, filters)
+}
@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/keycardai/terraform-provider-keycard/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &OrganizationDataSource{}
+
+func NewOrganizationDataSource() datasource.DataSource {
+	return &OrganizationDataSource{}
+}
+
+// OrganizationDataSource defines the data source implementation.
+type OrganizationDataSource struct {
+	client *client.ClientWithResponses
+}
+
+// OrganizationDataSourceModel describes the data source data model.
+type OrganizationDataSourceModel struct {
+	Slug      types.String `tfsdk:"slug"`
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Label     types.String `tfsdk:"label"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+func (d *OrganizationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization"
+}
+
+func (d *OrganizationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the Keycard organization the provider's credentials belong to.",
+
+		Attributes: map[string]schema.Attribute{
+			"slug": schema.StringAttribute{
+				MarkdownDescription: "Select the organization by slug. When omitted, the organization the credentials have access to is returned.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the organization.",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Display name of the organization.",
+				Computed:            true,
+			},
+			"label": schema.StringAttribute{
+				MarkdownDescription: "Domain name segment of the organization, as used in DNS names and console URLs.",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The time the organization was created, in UTC.",
+				Computed:            true,
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "The time the organization was most recently updated, in UTC.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *OrganizationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ClientWithResponses)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *OrganizationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data OrganizationDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var slug *string
+	if !data.Slug.IsNull() {
+		slug = data.Slug.ValueStringPointer()
+	}
+
+	organization, err := GetOrganization(ctx, d.client, slug)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read organization, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringPointerValue(organization.Id)
+	data.Name = types.StringPointerValue(organization.Name)
+	data.Label = types.StringPointerValue(organization.Label)
+	data.CreatedAt = organizationTimestampValue(organization.CreatedAt)
+	data.UpdatedAt = organizationTimestampValue(organization.UpdatedAt)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// organizationTimestampValue converts an untyped organization timestamp to a string value.
+// The API schema leaves these timestamps untyped, but they are returned as RFC 3339 strings.
+func organizationTimestampValue(val interface{}) types.String {
+	switch v := val.(type) {
+	case nil:
+		return types.StringNull()
+	case string:
+		return types.StringValue(v)
+	default:
+		return types.StringValue(fmt.Sprint(v))
+	}
+}
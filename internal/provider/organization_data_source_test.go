@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOrganizationDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Fetch the organization the credentials have access to
+			{
+				Config: testAccOrganizationDataSourceConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.keycard_organization.test", "id"),
+					resource.TestCheckResourceAttrSet("data.keycard_organization.test", "name"),
+					resource.TestCheckResourceAttrSet("data.keycard_organization.test", "label"),
+					resource.TestCheckResourceAttrSet("data.keycard_organization.test", "created_at"),
+					resource.TestCheckResourceAttrSet("data.keycard_organization.test", "updated_at"),
+					resource.TestCheckNoResourceAttr("data.keycard_organization.test", "slug"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOrganizationDataSource_bySlug(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Look up the organization again by its slug and verify it resolves to the same organization
+			{
+				Config: testAccOrganizationDataSourceConfig_bySlug(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.keycard_organization.by_slug", "id",
+						"data.keycard_organization.test", "id",
+					),
+					resource.TestCheckResourceAttrPair(
+						"data.keycard_organization.by_slug", "name",
+						"data.keycard_organization.test", "name",
+					),
+					resource.TestCheckResourceAttrPair(
+						"data.keycard_organization.by_slug", "slug",
+						"data.keycard_organization.test", "label",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testAccOrganizationDataSourceConfig_basic() string {
+	return `
+data "keycard_organization" "test" {}
+`
+}
+
+func testAccOrganizationDataSourceConfig_bySlug() string {
+	return `
+data "keycard_organization" "test" {}
+
+data "keycard_organization" "by_slug" {
+  slug = data.keycard_organization.test.label
+}
+`
+}
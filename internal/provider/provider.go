@@ -131,6 +131,7 @@ func (p *KeycardProvider) Configure(ctx context.Context, req provider.ConfigureR
 
 	resp.DataSourceData = apiClient
 	resp.ResourceData = apiClient
+	resp.EphemeralResourceData = apiClient
 }
 
 func (p *KeycardProvider) Resources(ctx context.Context) []func() resource.Resource {
@@ -140,7 +141,10 @@ func (p *KeycardProvider) Resources(ctx context.Context) []func() resource.Resou
 		NewZoneUserIdentityConfigResource,
 		NewApplicationResource,
 		NewApplicationClientSecretResource,
+		NewApplicationCredentialResource,
 		NewApplicationURLCredentialResource,
+		NewApplicationPublicKeyCredentialResource,
+		NewApplicationPublicCredentialResource,
 		NewApplicationWorkloadIdentityResource,
 		NewResourceResource,
 		NewApplicationDependencyResource,
@@ -149,18 +153,30 @@ func (p *KeycardProvider) Resources(ctx context.Context) []func() resource.Resou
 }
 
 func (p *KeycardProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
-	return []func() ephemeral.EphemeralResource{}
+	return []func() ephemeral.EphemeralResource{
+		NewApplicationClientSecretEphemeralResource,
+		NewAccessTokenEphemeralResource,
+	}
 }
 
 func (p *KeycardProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewZoneDataSource,
+		NewZonesDataSource,
 		NewProviderDataSource,
+		NewProvidersDataSource,
 		NewZoneUserIdentityConfigDataSource,
 		NewApplicationDataSource,
+		NewApplicationCredentialsDataSource,
+		NewApplicationDependenciesDataSource,
+		NewApplicationResourcesDataSource,
+		NewApplicationsDataSource,
 		NewApplicationWorkloadIdentityDataSource,
 		NewResourceDataSource,
+		NewResourcesDataSource,
 		NewAwsKmsKeyPolicyDataSource,
+		NewOrganizationDataSource,
+		NewSSOConnectionDataSource,
 	}
 }
 
@@ -28,13 +28,20 @@ type ProviderDataSource struct {
 // ProviderDataSourceModel describes the data source data model.
 // Note: This model excludes client_secret since it's write-only and not returned by the API.
 type ProviderDataSourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	ZoneID      types.String `tfsdk:"zone_id"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
-	Identifier  types.String `tfsdk:"identifier"`
-	ClientID    types.String `tfsdk:"client_id"`
-	OAuth2      types.Object `tfsdk:"oauth2"`
+	ID              types.String `tfsdk:"id"`
+	ZoneID          types.String `tfsdk:"zone_id"`
+	Slug            types.String `tfsdk:"slug"`
+	OrganizationID  types.String `tfsdk:"organization_id"`
+	CreatedAt       types.String `tfsdk:"created_at"`
+	UpdatedAt       types.String `tfsdk:"updated_at"`
+	Name            types.String `tfsdk:"name"`
+	Description     types.String `tfsdk:"description"`
+	Identifier      types.String `tfsdk:"identifier"`
+	ClientID        types.String `tfsdk:"client_id"`
+	ClientSecretSet types.Bool   `tfsdk:"client_secret_set"`
+	Type            types.String `tfsdk:"type"`
+	OAuth2          types.Object `tfsdk:"oauth2"`
+	OpenID          types.Object `tfsdk:"openid"`
 }
 
 func (d *ProviderDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -45,48 +52,28 @@ func (d *ProviderDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Reads a Keycard provider. A provider is a system that supplies access to resources and allows actors (users or applications) to authenticate.",
 
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				MarkdownDescription: "Unique identifier of the provider. Either `id` or `identifier` must be provided, but not both.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"zone_id": schema.StringAttribute{
-				MarkdownDescription: "The zone this provider belongs to.",
-				Required:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "Human-readable name for the provider.",
-				Computed:            true,
-			},
-			"description": schema.StringAttribute{
-				MarkdownDescription: "Optional description of the provider's purpose. May be empty.",
-				Computed:            true,
-			},
-			"identifier": schema.StringAttribute{
-				MarkdownDescription: "User-specified identifier, unique within the zone. Either `id` or `identifier` must be provided, but not both.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"client_id": schema.StringAttribute{
-				MarkdownDescription: "OAuth 2.0 client identifier. May be empty.",
-				Computed:            true,
-			},
-			"oauth2": schema.SingleNestedAttribute{
-				MarkdownDescription: "OAuth 2.0 protocol configuration. May be empty.",
-				Computed:            true,
-				Attributes: map[string]schema.Attribute{
-					"authorization_endpoint": schema.StringAttribute{
-						MarkdownDescription: "OAuth 2.0 Authorization endpoint URL. May be empty.",
-						Computed:            true,
-					},
-					"token_endpoint": schema.StringAttribute{
-						MarkdownDescription: "OAuth 2.0 Token endpoint URL. May be empty.",
-						Computed:            true,
-					},
-				},
-			},
-		},
+		Attributes: providerDataSourceAttributes(),
+	}
+
+	// The provider is looked up by either ID, identifier or slug within a zone
+	resp.Schema.Attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "Unique identifier of the provider. Exactly one of `id`, `identifier` or `slug` must be provided.",
+		Optional:            true,
+		Computed:            true,
+	}
+	resp.Schema.Attributes["zone_id"] = schema.StringAttribute{
+		MarkdownDescription: "The zone this provider belongs to.",
+		Required:            true,
+	}
+	resp.Schema.Attributes["identifier"] = schema.StringAttribute{
+		MarkdownDescription: "User-specified identifier, unique within the zone. Exactly one of `id`, `identifier` or `slug` must be provided.",
+		Optional:            true,
+		Computed:            true,
+	}
+	resp.Schema.Attributes["slug"] = schema.StringAttribute{
+		MarkdownDescription: "URL-safe identifier of the provider, unique within the zone. Exactly one of `id`, `identifier` or `slug` must be provided.",
+		Optional:            true,
+		Computed:            true,
 	}
 }
 
@@ -115,6 +102,7 @@ func (d *ProviderDataSource) ConfigValidators(ctx context.Context) []datasource.
 		datasourcevalidator.ExactlyOneOf(
 			path.MatchRoot("id"),
 			path.MatchRoot("identifier"),
+			path.MatchRoot("slug"),
 		),
 	}
 }
@@ -162,11 +150,17 @@ func (d *ProviderDataSource) Read(ctx context.Context, req datasource.ReadReques
 
 		provider = getResp.JSON200
 	} else {
-		// Lookup by identifier
-		identifier := data.Identifier.ValueString()
-		listResp, err := d.client.ListProvidersWithResponse(ctx, data.ZoneID.ValueString(), &client.ListProvidersParams{
-			Identifier: &identifier,
-		})
+		// Lookup by identifier or slug
+		params := client.ListProvidersParams{}
+		selector := fmt.Sprintf("identifier '%s'", data.Identifier.ValueString())
+		if !data.Identifier.IsNull() {
+			params.Identifier = data.Identifier.ValueStringPointer()
+		} else {
+			params.Slug = data.Slug.ValueStringPointer()
+			selector = fmt.Sprintf("slug '%s'", data.Slug.ValueString())
+		}
+
+		listResp, err := d.client.ListProvidersWithResponse(ctx, data.ZoneID.ValueString(), &params)
 		if err != nil {
 			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list providers: %s", err))
 			return
@@ -181,7 +175,7 @@ func (d *ProviderDataSource) Read(ctx context.Context, req datasource.ReadReques
 		if resultCount == 0 {
 			resp.Diagnostics.AddError(
 				"Provider Not Found",
-				fmt.Sprintf("No provider found with identifier '%s' in zone '%s'", identifier, data.ZoneID.ValueString()),
+				fmt.Sprintf("No provider found with %s in zone '%s'", selector, data.ZoneID.ValueString()),
 			)
 			return
 		}
@@ -189,8 +183,8 @@ func (d *ProviderDataSource) Read(ctx context.Context, req datasource.ReadReques
 		if resultCount > 1 {
 			resp.Diagnostics.AddError(
 				"Multiple Providers Found",
-				fmt.Sprintf("Expected exactly 1 provider with identifier '%s' in zone '%s', but found %d. This indicates a data integrity issue.",
-					identifier, data.ZoneID.ValueString(), resultCount),
+				fmt.Sprintf("Expected exactly 1 provider with %s in zone '%s', but found %d. This indicates a data integrity issue.",
+					selector, data.ZoneID.ValueString(), resultCount),
 			)
 			return
 		}
@@ -207,3 +201,100 @@ func (d *ProviderDataSource) Read(ctx context.Context, req datasource.ReadReques
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// providerDataSourceAttributes returns the computed attributes describing a provider in data sources.
+// Data sources that look up a single provider override the attributes used as lookup arguments.
+func providerDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Unique identifier of the provider.",
+			Computed:            true,
+		},
+		"slug": schema.StringAttribute{
+			MarkdownDescription: "URL-safe identifier of the provider, unique within the zone.",
+			Computed:            true,
+		},
+		"organization_id": schema.StringAttribute{
+			MarkdownDescription: "The organization that owns the provider.",
+			Computed:            true,
+		},
+		"created_at": schema.StringAttribute{
+			MarkdownDescription: "The time the provider was created, in RFC 3339 format.",
+			Computed:            true,
+		},
+		"updated_at": schema.StringAttribute{
+			MarkdownDescription: "The time the provider was last updated, in RFC 3339 format.",
+			Computed:            true,
+		},
+		"zone_id": schema.StringAttribute{
+			MarkdownDescription: "The zone this provider belongs to.",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Human-readable name for the provider.",
+			Computed:            true,
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "Optional description of the provider's purpose. May be empty.",
+			Computed:            true,
+		},
+		"identifier": schema.StringAttribute{
+			MarkdownDescription: "User-specified identifier, unique within the zone.",
+			Computed:            true,
+		},
+		"client_id": schema.StringAttribute{
+			MarkdownDescription: "OAuth 2.0 client identifier. May be empty.",
+			Computed:            true,
+		},
+		"client_secret_set": schema.BoolAttribute{
+			MarkdownDescription: "Whether a client secret is configured for the provider. The secret itself is never returned.",
+			Computed:            true,
+		},
+		"type": schema.StringAttribute{
+			MarkdownDescription: "The type of the provider. One of `external`, `keycard-vault` or `keycard-sts`.",
+			Computed:            true,
+		},
+		"oauth2": schema.SingleNestedAttribute{
+			MarkdownDescription: "OAuth 2.0 protocol configuration. May be empty.",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"authorization_endpoint": schema.StringAttribute{
+					MarkdownDescription: "OAuth 2.0 Authorization endpoint URL. May be empty.",
+					Computed:            true,
+				},
+				"token_endpoint": schema.StringAttribute{
+					MarkdownDescription: "OAuth 2.0 Token endpoint URL. May be empty.",
+					Computed:            true,
+				},
+				"registration_endpoint": schema.StringAttribute{
+					MarkdownDescription: "OAuth 2.0 Dynamic Client Registration endpoint URL. May be empty.",
+					Computed:            true,
+				},
+				"jwks_uri": schema.StringAttribute{
+					MarkdownDescription: "JSON Web Key Set URL used to validate tokens issued by the provider. May be empty.",
+					Computed:            true,
+				},
+				"code_challenge_methods_supported": schema.SetAttribute{
+					MarkdownDescription: "PKCE code challenge methods supported by the provider. May be empty.",
+					ElementType:         types.StringType,
+					Computed:            true,
+				},
+				"scopes_supported": schema.SetAttribute{
+					MarkdownDescription: "OAuth 2.0 scopes supported by the provider. May be empty.",
+					ElementType:         types.StringType,
+					Computed:            true,
+				},
+			},
+		},
+		"openid": schema.SingleNestedAttribute{
+			MarkdownDescription: "OpenID Connect protocol configuration. May be empty.",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"userinfo_endpoint": schema.StringAttribute{
+					MarkdownDescription: "OpenID Connect UserInfo endpoint URL. May be empty.",
+					Computed:            true,
+				},
+			},
+		},
+	}
+}
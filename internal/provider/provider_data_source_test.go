@@ -38,6 +38,8 @@ func TestAccProviderDataSource_basic(t *testing.T) {
 						"data.keycard_provider.test", "identifier",
 						"keycard_provider.test", "identifier",
 					),
+					resource.TestCheckResourceAttr("data.keycard_provider.test", "type", "external"),
+					resource.TestCheckResourceAttr("data.keycard_provider.test", "client_secret_set", "false"),
 					resource.TestCheckResourceAttr("data.keycard_provider.test", "name", rName),
 					resource.TestCheckResourceAttr("data.keycard_provider.test", "identifier", identifier),
 				),
@@ -102,6 +104,18 @@ func TestAccProviderDataSource_withOAuth2(t *testing.T) {
 						"data.keycard_provider.test", "oauth2.token_endpoint",
 						"keycard_provider.test", "oauth2.token_endpoint",
 					),
+					resource.TestCheckResourceAttrPair(
+						"data.keycard_provider.test", "oauth2.jwks_uri",
+						"keycard_provider.test", "oauth2.jwks_uri",
+					),
+					resource.TestCheckResourceAttrPair(
+						"data.keycard_provider.test", "oauth2.scopes_supported.#",
+						"keycard_provider.test", "oauth2.scopes_supported.#",
+					),
+					resource.TestCheckResourceAttrPair(
+						"data.keycard_provider.test", "openid.userinfo_endpoint",
+						"keycard_provider.test", "openid.userinfo_endpoint",
+					),
 					resource.TestCheckResourceAttr("data.keycard_provider.test", "client_id", "test-client-id"),
 					resource.TestCheckResourceAttr("data.keycard_provider.test", "oauth2.authorization_endpoint", identifier+"/authorize"),
 					resource.TestCheckResourceAttr("data.keycard_provider.test", "oauth2.token_endpoint", identifier+"/token"),
@@ -204,3 +218,60 @@ data "keycard_provider" "test" {
 }
 `, name)
 }
+
+func TestAccProviderDataSource_bySlug(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tftest")
+	identifier := fmt.Sprintf("https://%s.example.com", rName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create a provider and fetch it by slug
+			{
+				Config: testAccProviderDataSourceConfig_bySlug(rName, identifier),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.keycard_provider.test", "id",
+						"keycard_provider.test", "id",
+					),
+					resource.TestCheckResourceAttrPair(
+						"data.keycard_provider.test", "slug",
+						"keycard_provider.test", "slug",
+					),
+					resource.TestCheckResourceAttrPair(
+						"data.keycard_provider.test", "organization_id",
+						"keycard_provider.test", "organization_id",
+					),
+					resource.TestCheckResourceAttrPair(
+						"data.keycard_provider.test", "created_at",
+						"keycard_provider.test", "created_at",
+					),
+					resource.TestCheckResourceAttrPair(
+						"data.keycard_provider.test", "updated_at",
+						"keycard_provider.test", "updated_at",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testAccProviderDataSourceConfig_bySlug(name, identifier string) string {
+	return fmt.Sprintf(`
+resource "keycard_zone" "test" {
+  name = %[1]q
+}
+
+resource "keycard_provider" "test" {
+  name       = %[1]q
+  zone_id    = keycard_zone.test.id
+  identifier = %[2]q
+}
+
+data "keycard_provider" "test" {
+  zone_id = keycard_provider.test.zone_id
+  slug    = keycard_provider.test.slug
+}
+`, name, identifier)
+}
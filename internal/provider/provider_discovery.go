@@ -0,0 +1,262 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/keycardai/terraform-provider-keycard/internal/client"
+)
+
+const (
+	// discoveryDocumentOpenIDConfiguration is the OpenID Connect Discovery 1.0 provider configuration document.
+	discoveryDocumentOpenIDConfiguration = "openid-configuration"
+
+	// discoveryDocumentOAuthAuthorizationServer is the RFC 8414 OAuth 2.0 Authorization Server Metadata document.
+	discoveryDocumentOAuthAuthorizationServer = "oauth-authorization-server"
+)
+
+// discoveryHTTPClient fetches provider metadata documents. Metadata is public, so requests are not authenticated.
+var discoveryHTTPClient client.HttpRequestDoer = client.NewLoggingHTTPClient(&http.Client{Timeout: 5 * time.Second})
+
+// ProviderDiscoveryModel describes the nested discovery block data model.
+type ProviderDiscoveryModel struct {
+	Document types.String `tfsdk:"document"`
+}
+
+func (m ProviderDiscoveryModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"document": types.StringType,
+	}
+}
+
+// providerMetadata holds the fields of a provider metadata document that map to the provider protocols.
+type providerMetadata struct {
+	Issuer                string   `json:"issuer"`
+	AuthorizationEndpoint string   `json:"authorization_endpoint"`
+	TokenEndpoint         string   `json:"token_endpoint"`
+	JwksURI               string   `json:"jwks_uri"`
+	UserinfoEndpoint      string   `json:"userinfo_endpoint"`
+	RegistrationEndpoint  string   `json:"registration_endpoint"`
+	ScopesSupported       []string `json:"scopes_supported"`
+}
+
+// discoveryDocumentURL returns the location of the given metadata document for an issuer.
+// OpenID Connect appends the well-known path to the issuer, while RFC 8414 inserts it
+// between the host and the path of the issuer.
+func discoveryDocumentURL(issuer, document string) (string, error) {
+	u, err := url.Parse(issuer)
+	if err != nil {
+		return "", fmt.Errorf("invalid issuer URL: %w", err)
+	}
+
+	if u.Scheme == "" || u.Host == "" {
+		return "", fmt.Errorf("issuer %q is not an absolute URL", issuer)
+	}
+
+	issuerPath := strings.TrimSuffix(u.Path, "/")
+
+	switch document {
+	case discoveryDocumentOpenIDConfiguration:
+		u.Path = issuerPath + "/.well-known/openid-configuration"
+	case discoveryDocumentOAuthAuthorizationServer:
+		u.Path = "/.well-known/oauth-authorization-server" + issuerPath
+	default:
+		return "", fmt.Errorf("unsupported discovery document %q", document)
+	}
+
+	u.RawPath = ""
+	u.RawQuery = ""
+	u.Fragment = ""
+
+	return u.String(), nil
+}
+
+// fetchProviderMetadata retrieves and decodes a single metadata document for the issuer.
+func fetchProviderMetadata(ctx context.Context, httpClient client.HttpRequestDoer, issuer, document string) (*providerMetadata, error) {
+	documentURL, err := discoveryDocumentURL(issuer, document)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, documentURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to build request for %s: %w", documentURL, err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	httpResp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch %s: %w", documentURL, err)
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unable to fetch %s, got status %d", documentURL, httpResp.StatusCode)
+	}
+
+	// Metadata documents are small, anything larger is not a metadata document
+	body, err := io.ReadAll(io.LimitReader(httpResp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read %s: %w", documentURL, err)
+	}
+
+	var metadata providerMetadata
+	if err := json.Unmarshal(body, &metadata); err != nil {
+		return nil, fmt.Errorf("unable to parse %s: %w", documentURL, err)
+	}
+
+	// The issuer in the document must match the issuer it was retrieved for
+	if metadata.Issuer != "" && strings.TrimSuffix(metadata.Issuer, "/") != strings.TrimSuffix(issuer, "/") {
+		return nil, fmt.Errorf("%s was issued for %q instead of %q", documentURL, metadata.Issuer, issuer)
+	}
+
+	return &metadata, nil
+}
+
+// discoverProviderMetadata retrieves the metadata document of the issuer. When no document is
+// specified, the OpenID Connect configuration is tried first, falling back to the RFC 8414
+// authorization server metadata.
+func discoverProviderMetadata(ctx context.Context, httpClient client.HttpRequestDoer, issuer, document string) (*providerMetadata, error) {
+	if document != "" {
+		return fetchProviderMetadata(ctx, httpClient, issuer, document)
+	}
+
+	metadata, oidcErr := fetchProviderMetadata(ctx, httpClient, issuer, discoveryDocumentOpenIDConfiguration)
+	if oidcErr == nil {
+		return metadata, nil
+	}
+
+	metadata, oauthErr := fetchProviderMetadata(ctx, httpClient, issuer, discoveryDocumentOAuthAuthorizationServer)
+	if oauthErr == nil {
+		return metadata, nil
+	}
+
+	return nil, errors.Join(oidcErr, oauthErr)
+}
+
+// discoveredStringValue returns the discovered value for an attribute that is not set in the configuration,
+// and the planned value otherwise.
+func discoveredStringValue(configValue, planValue types.String, discovered string) types.String {
+	if !configValue.IsNull() || discovered == "" {
+		return planValue
+	}
+
+	return types.StringValue(discovered)
+}
+
+// discoveredSetValue returns the discovered values for an attribute that is not set in the configuration,
+// and the planned value otherwise.
+func discoveredSetValue(ctx context.Context, configValue, planValue types.Set, discovered []string) (types.Set, diag.Diagnostics) {
+	if !configValue.IsNull() || len(discovered) == 0 {
+		return planValue, nil
+	}
+
+	return types.SetValueFrom(ctx, types.StringType, discovered)
+}
+
+// planDiscoveredProtocols fills in the oauth2 and openid attributes of the plan from the provider's
+// metadata document when discovery is enabled. Attributes set in the configuration take precedence.
+func (r *ProviderResource) planDiscoveredProtocols(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var config, plan ProviderResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Discovery is opt-in, and can only run once the identifier is known
+	if config.Discovery.IsNull() || config.Discovery.IsUnknown() || config.Identifier.IsUnknown() {
+		return
+	}
+
+	var discovery ProviderDiscoveryModel
+	resp.Diagnostics.Append(config.Discovery.As(ctx, &discovery, basetypes.ObjectAsOptions{})...)
+	if resp.Diagnostics.HasError() || discovery.Document.IsUnknown() {
+		return
+	}
+
+	identifier := config.Identifier.ValueString()
+	metadata, err := discoverProviderMetadata(ctx, discoveryHTTPClient, identifier, discovery.Document.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("identifier"),
+			"Provider Discovery Failed",
+			fmt.Sprintf("Unable to discover the configuration of provider %s: %s", identifier, err),
+		)
+		return
+	}
+
+	// Unset attributes of unknown planned objects remain unknown unless they are discovered
+	configOAuth2, planOAuth2 := OAuth2ProviderModel{}, OAuth2ProviderModel{
+		AuthorizationEndpoint:         types.StringUnknown(),
+		TokenEndpoint:                 types.StringUnknown(),
+		RegistrationEndpoint:          types.StringUnknown(),
+		JwksURI:                       types.StringUnknown(),
+		CodeChallengeMethodsSupported: types.SetUnknown(types.StringType),
+		ScopesSupported:               types.SetUnknown(types.StringType),
+	}
+	if !config.OAuth2.IsNull() && !config.OAuth2.IsUnknown() {
+		resp.Diagnostics.Append(config.OAuth2.As(ctx, &configOAuth2, basetypes.ObjectAsOptions{})...)
+	}
+	if !plan.OAuth2.IsUnknown() {
+		resp.Diagnostics.Append(plan.OAuth2.As(ctx, &planOAuth2, basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true})...)
+	}
+
+	configOpenID, planOpenID := OpenIDProviderModel{}, OpenIDProviderModel{
+		UserinfoEndpoint: types.StringUnknown(),
+	}
+	if !config.OpenID.IsNull() && !config.OpenID.IsUnknown() {
+		resp.Diagnostics.Append(config.OpenID.As(ctx, &configOpenID, basetypes.ObjectAsOptions{})...)
+	}
+	if !plan.OpenID.IsUnknown() {
+		resp.Diagnostics.Append(plan.OpenID.As(ctx, &planOpenID, basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true})...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A null planned set carries no element type, so restore it before building the object
+	if planOAuth2.CodeChallengeMethodsSupported.ElementType(ctx) == nil {
+		planOAuth2.CodeChallengeMethodsSupported = types.SetNull(types.StringType)
+	}
+	if planOAuth2.ScopesSupported.ElementType(ctx) == nil {
+		planOAuth2.ScopesSupported = types.SetNull(types.StringType)
+	}
+
+	planOAuth2.AuthorizationEndpoint = discoveredStringValue(configOAuth2.AuthorizationEndpoint, planOAuth2.AuthorizationEndpoint, metadata.AuthorizationEndpoint)
+	planOAuth2.TokenEndpoint = discoveredStringValue(configOAuth2.TokenEndpoint, planOAuth2.TokenEndpoint, metadata.TokenEndpoint)
+	planOAuth2.RegistrationEndpoint = discoveredStringValue(configOAuth2.RegistrationEndpoint, planOAuth2.RegistrationEndpoint, metadata.RegistrationEndpoint)
+	planOAuth2.JwksURI = discoveredStringValue(configOAuth2.JwksURI, planOAuth2.JwksURI, metadata.JwksURI)
+
+	scopes, diags := discoveredSetValue(ctx, configOAuth2.ScopesSupported, planOAuth2.ScopesSupported, metadata.ScopesSupported)
+	resp.Diagnostics.Append(diags...)
+	planOAuth2.ScopesSupported = scopes
+
+	planOpenID.UserinfoEndpoint = discoveredStringValue(configOpenID.UserinfoEndpoint, planOpenID.UserinfoEndpoint, metadata.UserinfoEndpoint)
+
+	oauth2Obj, diags := types.ObjectValueFrom(ctx, planOAuth2.AttributeTypes(), planOAuth2)
+	resp.Diagnostics.Append(diags...)
+	openIDObj, diags := types.ObjectValueFrom(ctx, planOpenID.AttributeTypes(), planOpenID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("oauth2"), oauth2Obj)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("openid"), openIDObj)...)
+}
@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// newTestDiscoveryServer serves metadata documents for the issuer at the server root. Documents
+// not listed in the given set respond with 404 Not Found.
+func newTestDiscoveryServer(t *testing.T, documents ...string) *httptest.Server {
+	t.Helper()
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for _, document := range documents {
+			if r.URL.Path != "/.well-known/"+document {
+				continue
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			_, err := fmt.Fprintf(w, `{
+  "issuer": %[1]q,
+  "authorization_endpoint": "%[1]s/authorize",
+  "token_endpoint": "%[1]s/token",
+  "jwks_uri": "%[1]s/%[2]s/jwks",
+  "userinfo_endpoint": "%[1]s/userinfo",
+  "registration_endpoint": "%[1]s/register",
+  "scopes_supported": ["openid", "profile"]
+}`, server.URL, document)
+			if err != nil {
+				panic(err)
+			}
+			return
+		}
+
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestDiscoveryDocumentURL(t *testing.T) {
+	tests := []struct {
+		issuer   string
+		document string
+		want     string
+	}{
+		{"https://idp.example.com", discoveryDocumentOpenIDConfiguration, "https://idp.example.com/.well-known/openid-configuration"},
+		{"https://idp.example.com/", discoveryDocumentOpenIDConfiguration, "https://idp.example.com/.well-known/openid-configuration"},
+		{"https://idp.example.com/tenant", discoveryDocumentOpenIDConfiguration, "https://idp.example.com/tenant/.well-known/openid-configuration"},
+		{"https://idp.example.com", discoveryDocumentOAuthAuthorizationServer, "https://idp.example.com/.well-known/oauth-authorization-server"},
+		{"https://idp.example.com/tenant", discoveryDocumentOAuthAuthorizationServer, "https://idp.example.com/.well-known/oauth-authorization-server/tenant"},
+	}
+
+	for _, tt := range tests {
+		got, err := discoveryDocumentURL(tt.issuer, tt.document)
+		if err != nil {
+			t.Fatalf("discoveryDocumentURL(%q, %q) returned error: %v", tt.issuer, tt.document, err)
+		}
+		if got != tt.want {
+			t.Errorf("discoveryDocumentURL(%q, %q) = %q, want %q", tt.issuer, tt.document, got, tt.want)
+		}
+	}
+
+	if _, err := discoveryDocumentURL("idp.example.com", discoveryDocumentOpenIDConfiguration); err == nil {
+		t.Error("discoveryDocumentURL with a relative issuer did not return an error")
+	}
+}
+
+func TestDiscoverProviderMetadata(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("openid-configuration", func(t *testing.T) {
+		server := newTestDiscoveryServer(t, discoveryDocumentOpenIDConfiguration, discoveryDocumentOAuthAuthorizationServer)
+
+		metadata, err := discoverProviderMetadata(ctx, server.Client(), server.URL, "")
+		if err != nil {
+			t.Fatalf("discoverProviderMetadata returned error: %v", err)
+		}
+
+		if want := server.URL + "/openid-configuration/jwks"; metadata.JwksURI != want {
+			t.Errorf("jwks_uri = %q, want %q", metadata.JwksURI, want)
+		}
+		if want := server.URL + "/userinfo"; metadata.UserinfoEndpoint != want {
+			t.Errorf("userinfo_endpoint = %q, want %q", metadata.UserinfoEndpoint, want)
+		}
+		if len(metadata.ScopesSupported) != 2 {
+			t.Errorf("scopes_supported = %v, want 2 scopes", metadata.ScopesSupported)
+		}
+	})
+
+	t.Run("oauth-authorization-server fallback", func(t *testing.T) {
+		server := newTestDiscoveryServer(t, discoveryDocumentOAuthAuthorizationServer)
+
+		metadata, err := discoverProviderMetadata(ctx, server.Client(), server.URL, "")
+		if err != nil {
+			t.Fatalf("discoverProviderMetadata returned error: %v", err)
+		}
+
+		if want := server.URL + "/oauth-authorization-server/jwks"; metadata.JwksURI != want {
+			t.Errorf("jwks_uri = %q, want %q", metadata.JwksURI, want)
+		}
+	})
+
+	t.Run("explicit document", func(t *testing.T) {
+		server := newTestDiscoveryServer(t, discoveryDocumentOpenIDConfiguration)
+
+		_, err := discoverProviderMetadata(ctx, server.Client(), server.URL, discoveryDocumentOAuthAuthorizationServer)
+		if err == nil || !strings.Contains(err.Error(), "got status 404") {
+			t.Errorf("discoverProviderMetadata error = %v, want status 404", err)
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		server := newTestDiscoveryServer(t)
+
+		_, err := discoverProviderMetadata(ctx, server.Client(), server.URL, "")
+		if err == nil {
+			t.Fatal("discoverProviderMetadata did not return an error")
+		}
+
+		// Both documents are reported
+		for _, document := range []string{discoveryDocumentOpenIDConfiguration, discoveryDocumentOAuthAuthorizationServer} {
+			if !strings.Contains(err.Error(), document) {
+				t.Errorf("discoverProviderMetadata error = %v, want mention of %s", err, document)
+			}
+		}
+	})
+
+	t.Run("issuer mismatch", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, err := w.Write([]byte(`{"issuer":"https://other.example.com","token_endpoint":"https://other.example.com/token"}`))
+			if err != nil {
+				panic(err)
+			}
+		}))
+		defer server.Close()
+
+		_, err := discoverProviderMetadata(ctx, server.Client(), server.URL, discoveryDocumentOpenIDConfiguration)
+		if err == nil || !strings.Contains(err.Error(), "https://other.example.com") {
+			t.Errorf("discoverProviderMetadata error = %v, want issuer mismatch", err)
+		}
+	})
+}
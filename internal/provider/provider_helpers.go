@@ -2,7 +2,9 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -11,6 +13,15 @@ import (
 	"github.com/oapi-codegen/nullable"
 )
 
+// providerTypeValue maps the type of a provider API response. Providers without a type are external providers.
+func providerTypeValue(providerType *client.ProviderType) types.String {
+	if providerType == nil {
+		return types.StringValue(string(client.ProviderTypeExternal))
+	}
+
+	return types.StringValue(string(*providerType))
+}
+
 // updateProviderModelFromAPIResponse updates the model with data from a provider API response.
 // It handles mapping of all fields except client_secret, which should be preserved from plan/state.
 func updateProviderModelFromAPIResponse(ctx context.Context, provider *client.Provider, data *ProviderResourceModel) diag.Diagnostics {
@@ -18,33 +29,23 @@ func updateProviderModelFromAPIResponse(ctx context.Context, provider *client.Pr
 
 	// Map basic fields
 	data.ID = types.StringValue(provider.Id)
+	data.Slug = types.StringValue(provider.Slug)
+	data.OrganizationID = types.StringValue(provider.OrganizationId)
+	data.CreatedAt = TimestampValue(provider.CreatedAt)
+	data.UpdatedAt = TimestampValue(provider.UpdatedAt)
 	data.Name = types.StringValue(provider.Name)
 	data.Description = NullableStringValue(provider.Description)
 	data.Identifier = types.StringValue(provider.Identifier)
 	data.ClientID = NullableStringValue(provider.ClientId)
 
+	data.Type = providerTypeValue(provider.Type)
+
 	// Note: client_secret is not updated here as it's write-only in the API
 	// It should already be set from plan/state in the calling method
-	protocols, err := provider.Protocols.Get()
-	if err == nil {
-		oauth2, err := protocols.Oauth2.Get()
-		if err == nil {
-			oauth2Model := OAuth2ProviderModel{
-				AuthorizationEndpoint: NullableStringValue(oauth2.AuthorizationEndpoint),
-				TokenEndpoint:         NullableStringValue(oauth2.TokenEndpoint),
-			}
-			oauth2Obj, oauth2Diags := types.ObjectValueFrom(ctx, oauth2Model.AttributeTypes(), oauth2Model)
-			diags.Append(oauth2Diags...)
-			if diags.HasError() {
-				return diags
-			}
-			data.OAuth2 = oauth2Obj
-		} else {
-			data.OAuth2 = types.ObjectNull(OAuth2ProviderModel{}.AttributeTypes())
-		}
-	} else {
-		data.OAuth2 = types.ObjectNull(OAuth2ProviderModel{}.AttributeTypes())
-	}
+	oauth2Obj, openIDObj, protocolDiags := providerProtocolValues(ctx, provider)
+	diags.Append(protocolDiags...)
+	data.OAuth2 = oauth2Obj
+	data.OpenID = openIDObj
 
 	return diags
 }
@@ -56,34 +57,70 @@ func updateProviderDataSourceModelFromAPIResponse(ctx context.Context, provider
 
 	// Map basic fields
 	data.ID = types.StringValue(provider.Id)
+	data.Slug = types.StringValue(provider.Slug)
+	data.OrganizationID = types.StringValue(provider.OrganizationId)
+	data.CreatedAt = TimestampValue(provider.CreatedAt)
+	data.UpdatedAt = TimestampValue(provider.UpdatedAt)
 	data.Name = types.StringValue(provider.Name)
 	data.Description = NullableStringValue(provider.Description)
 	data.Identifier = types.StringValue(provider.Identifier)
+	data.ZoneID = types.StringValue(provider.ZoneId)
 	data.ClientID = NullableStringValue(provider.ClientId)
+	data.ClientSecretSet = types.BoolPointerValue(provider.ClientSecretSet)
+
+	data.Type = providerTypeValue(provider.Type)
 
 	// Map protocols.oauth2 fields if present
+	oauth2Obj, openIDObj, protocolDiags := providerProtocolValues(ctx, provider)
+	diags.Append(protocolDiags...)
+	data.OAuth2 = oauth2Obj
+	data.OpenID = openIDObj
+
+	return diags
+}
+
+// providerProtocolValues maps the protocols of a Provider API response to the oauth2 and openid object values.
+// Protocols missing from the response are mapped to null objects.
+func providerProtocolValues(ctx context.Context, provider *client.Provider) (types.Object, types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	oauth2Obj := types.ObjectNull(OAuth2ProviderModel{}.AttributeTypes())
+	openIDObj := types.ObjectNull(OpenIDProviderModel{}.AttributeTypes())
+
 	protocols, err := provider.Protocols.Get()
-	if err == nil {
-		oauth2, err := protocols.Oauth2.Get()
-		if err == nil {
-			oauth2Model := OAuth2ProviderModel{
-				AuthorizationEndpoint: NullableStringValue(oauth2.AuthorizationEndpoint),
-				TokenEndpoint:         NullableStringValue(oauth2.TokenEndpoint),
-			}
-			oauth2Obj, oauth2Diags := types.ObjectValueFrom(ctx, oauth2Model.AttributeTypes(), oauth2Model)
-			diags.Append(oauth2Diags...)
-			if diags.HasError() {
-				return diags
-			}
-			data.OAuth2 = oauth2Obj
-		} else {
-			data.OAuth2 = types.ObjectNull(OAuth2ProviderModel{}.AttributeTypes())
+	if err != nil {
+		return oauth2Obj, openIDObj, diags
+	}
+
+	if oauth2, err := protocols.Oauth2.Get(); err == nil {
+		codeChallengeMethods, setDiags := NullableStringSetValue(ctx, oauth2.CodeChallengeMethodsSupported)
+		diags.Append(setDiags...)
+		scopes, setDiags := NullableStringSetValue(ctx, oauth2.ScopesSupported)
+		diags.Append(setDiags...)
+
+		oauth2Model := OAuth2ProviderModel{
+			AuthorizationEndpoint:         NullableStringValue(oauth2.AuthorizationEndpoint),
+			TokenEndpoint:                 NullableStringValue(oauth2.TokenEndpoint),
+			RegistrationEndpoint:          NullableStringValue(oauth2.RegistrationEndpoint),
+			JwksURI:                       NullableStringValue(oauth2.JwksUri),
+			CodeChallengeMethodsSupported: codeChallengeMethods,
+			ScopesSupported:               scopes,
 		}
-	} else {
-		data.OAuth2 = types.ObjectNull(OAuth2ProviderModel{}.AttributeTypes())
+		obj, objDiags := types.ObjectValueFrom(ctx, oauth2Model.AttributeTypes(), oauth2Model)
+		diags.Append(objDiags...)
+		oauth2Obj = obj
 	}
 
-	return diags
+	if openid, err := protocols.Openid.Get(); err == nil {
+		openIDModel := OpenIDProviderModel{
+			UserinfoEndpoint: NullableStringValue(openid.UserinfoEndpoint),
+		}
+		obj, objDiags := types.ObjectValueFrom(ctx, openIDModel.AttributeTypes(), openIDModel)
+		diags.Append(objDiags...)
+		openIDObj = obj
+	}
+
+	return oauth2Obj, openIDObj, diags
 }
 
 func NullableStringValue(val nullable.Nullable[string]) basetypes.StringValue {
@@ -95,6 +132,11 @@ func NullableStringValue(val nullable.Nullable[string]) basetypes.StringValue {
 	return types.StringValue(str)
 }
 
+// TimestampValue converts an API timestamp to an RFC 3339 string value in UTC.
+func TimestampValue(val time.Time) basetypes.StringValue {
+	return types.StringValue(val.UTC().Format(time.RFC3339))
+}
+
 func StringValueNullable(val basetypes.StringValue) nullable.Nullable[string] {
 	switch {
 	case val.IsNull():
@@ -106,6 +148,28 @@ func StringValueNullable(val basetypes.StringValue) nullable.Nullable[string] {
 	}
 }
 
+func NullableStringSetValue(ctx context.Context, val nullable.Nullable[[]string]) (basetypes.SetValue, diag.Diagnostics) {
+	items, err := val.Get()
+	if err != nil {
+		return types.SetNull(types.StringType), nil
+	}
+
+	return types.SetValueFrom(ctx, types.StringType, items)
+}
+
+func StringSetValueNullable(ctx context.Context, val basetypes.SetValue) (nullable.Nullable[[]string], diag.Diagnostics) {
+	switch {
+	case val.IsNull():
+		return nullable.NewNullNullable[[]string](), nil
+	case val.IsUnknown():
+		return nullable.Nullable[[]string]{}, nil
+	default:
+		var items []string
+		diags := val.ElementsAs(ctx, &items, false)
+		return nullable.NewNullableWithValue(items), diags
+	}
+}
+
 func BoolValueNullable(val basetypes.BoolValue) nullable.Nullable[bool] {
 	switch {
 	case val.IsNull():
@@ -117,6 +181,21 @@ func BoolValueNullable(val basetypes.BoolValue) nullable.Nullable[bool] {
 	}
 }
 
+// nextPageCursor returns the cursor for the page following the one described by pageInfo,
+// or nil when there are no more pages to fetch.
+func nextPageCursor(pageInfo client.PageInfo) *string {
+	if !pageInfo.HasNextPage {
+		return nil
+	}
+
+	cursor, err := pageInfo.EndCursor.Get()
+	if err != nil || cursor == "" {
+		return nil
+	}
+
+	return &cursor
+}
+
 // updateApplicationModelFromAPIResponse maps an Application API response to the ApplicationModel.
 // This is a shared helper function used by both the resource and data source.
 // It returns any diagnostics encountered during the mapping.
@@ -125,9 +204,14 @@ func updateApplicationModelFromAPIResponse(ctx context.Context, app *client.Appl
 
 	data.ID = types.StringValue(app.Id)
 	data.ZoneID = types.StringValue(app.ZoneId)
+	data.Slug = types.StringValue(app.Slug)
+	data.OrganizationID = types.StringValue(app.OrganizationId)
+	data.CreatedAt = TimestampValue(app.CreatedAt)
+	data.UpdatedAt = TimestampValue(app.UpdatedAt)
 	data.Name = types.StringValue(app.Name)
 	data.Description = NullableStringValue(app.Description)
 	data.Identifier = types.StringValue(app.Identifier)
+	data.DependenciesCount = types.Int64Value(int64(app.DependenciesCount))
 
 	// Handle metadata
 	if app.Metadata != nil && app.Metadata.DocsUrl != nil {
@@ -202,6 +286,10 @@ func updateApplicationClientSecretModelFromCreateResponse(cred *client.Applicati
 	// Map all fields including the password (client_secret)
 	data.ID = types.StringValue(passwordCred.Id)
 	data.ZoneID = types.StringValue(passwordCred.ZoneId)
+	data.Slug = types.StringValue(passwordCred.Slug)
+	data.OrganizationID = types.StringValue(passwordCred.OrganizationId)
+	data.CreatedAt = TimestampValue(passwordCred.CreatedAt)
+	data.UpdatedAt = TimestampValue(passwordCred.UpdatedAt)
 	data.ApplicationID = types.StringValue(passwordCred.ApplicationId)
 	data.ClientID = types.StringValue(passwordCred.Identifier)
 
@@ -217,6 +305,18 @@ func updateApplicationClientSecretModelFromCreateResponse(cred *client.Applicati
 func updateApplicationClientSecretModelFromAPIResponse(cred *client.ApplicationCredential, data *ApplicationClientSecretModel) diag.Diagnostics {
 	var diags diag.Diagnostics
 
+	// Imported credentials may be of any type, only password credentials hold a client secret
+	credType, err := applicationCredentialType(cred)
+	if err != nil {
+		diags.AddError("API Error", fmt.Sprintf("Unable to determine application credential type, got error: %s", err))
+		return diags
+	}
+
+	if credType != string(client.ApplicationCredentialPasswordTypePassword) {
+		diags.AddError("API Error", fmt.Sprintf("Expected password credential type, got %q", credType))
+		return diags
+	}
+
 	// The response is a union type, we need to check which type we got
 	// For password credentials, we expect ApplicationCredentialPassword
 	passwordCred, err := cred.AsApplicationCredentialPassword()
@@ -228,11 +328,16 @@ func updateApplicationClientSecretModelFromAPIResponse(cred *client.ApplicationC
 	// Update basic fields
 	data.ID = types.StringValue(passwordCred.Id)
 	data.ZoneID = types.StringValue(passwordCred.ZoneId)
+	data.Slug = types.StringValue(passwordCred.Slug)
+	data.OrganizationID = types.StringValue(passwordCred.OrganizationId)
+	data.CreatedAt = TimestampValue(passwordCred.CreatedAt)
+	data.UpdatedAt = TimestampValue(passwordCred.UpdatedAt)
 	data.ApplicationID = types.StringValue(passwordCred.ApplicationId)
 	data.ClientID = types.StringValue(passwordCred.Identifier)
 
 	// password is only ever returned on create, never on read so
-	// client_secret is preserved from existing state as it's write-only
+	// client_secret is preserved from existing state as it's write-only,
+	// imported credentials have no client_secret at all
 
 	return diags
 }
@@ -251,6 +356,10 @@ func updateApplicationWorkloadIdentityModelFromCreateResponse(cred *client.Appli
 	// Map all fields
 	data.ID = types.StringValue(tokenCred.Id)
 	data.ZoneID = types.StringValue(tokenCred.ZoneId)
+	data.Slug = types.StringValue(tokenCred.Slug)
+	data.OrganizationID = types.StringValue(tokenCred.OrganizationId)
+	data.CreatedAt = TimestampValue(tokenCred.CreatedAt)
+	data.UpdatedAt = TimestampValue(tokenCred.UpdatedAt)
 	data.ApplicationID = types.StringValue(tokenCred.ApplicationId)
 	data.ProviderID = types.StringValue(tokenCred.ProviderId)
 	data.Subject = NullableStringValue(tokenCred.Subject)
@@ -272,6 +381,10 @@ func updateApplicationWorkloadIdentityModelFromAPIResponse(cred *client.Applicat
 	// Map all fields
 	data.ID = types.StringValue(tokenCred.Id)
 	data.ZoneID = types.StringValue(tokenCred.ZoneId)
+	data.Slug = types.StringValue(tokenCred.Slug)
+	data.OrganizationID = types.StringValue(tokenCred.OrganizationId)
+	data.CreatedAt = TimestampValue(tokenCred.CreatedAt)
+	data.UpdatedAt = TimestampValue(tokenCred.UpdatedAt)
 	data.ApplicationID = types.StringValue(tokenCred.ApplicationId)
 	data.ProviderID = types.StringValue(tokenCred.ProviderId)
 	data.Subject = NullableStringValue(tokenCred.Subject)
@@ -295,6 +408,10 @@ func updateApplicationURLCredentialModelFromCreateResponse(cred *client.Applicat
 	// Map all fields
 	data.ID = types.StringValue(urlCred.Id)
 	data.ZoneID = types.StringValue(urlCred.ZoneId)
+	data.Slug = types.StringValue(urlCred.Slug)
+	data.OrganizationID = types.StringValue(urlCred.OrganizationId)
+	data.CreatedAt = TimestampValue(urlCred.CreatedAt)
+	data.UpdatedAt = TimestampValue(urlCred.UpdatedAt)
 	data.ApplicationID = types.StringValue(urlCred.ApplicationId)
 	data.URL = types.StringValue(urlCred.Identifier)
 
@@ -317,39 +434,472 @@ func updateApplicationURLCredentialModelFromAPIResponse(cred *client.Application
 	// Update all fields
 	data.ID = types.StringValue(urlCred.Id)
 	data.ZoneID = types.StringValue(urlCred.ZoneId)
+	data.Slug = types.StringValue(urlCred.Slug)
+	data.OrganizationID = types.StringValue(urlCred.OrganizationId)
+	data.CreatedAt = TimestampValue(urlCred.CreatedAt)
+	data.UpdatedAt = TimestampValue(urlCred.UpdatedAt)
 	data.ApplicationID = types.StringValue(urlCred.ApplicationId)
 	data.URL = types.StringValue(urlCred.Identifier)
 
 	return diags
 }
 
+// updateApplicationPublicKeyCredentialModelFromCreateResponse updates the model with data from the
+// ApplicationCredentialCreateResponse. This function is called during Create.
+func updateApplicationPublicKeyCredentialModelFromCreateResponse(cred *client.ApplicationCredentialCreateResponse, data *ApplicationPublicKeyCredentialModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// The response is a union type, we need to check which type we got
+	// For public key credentials, we expect ApplicationCredentialPublicKey
+	publicKeyCred, err := cred.AsApplicationCredentialPublicKey()
+	if err != nil {
+		diags.AddError("API Error", fmt.Sprintf("Expected public key credential response, got error: %s", err))
+		return diags
+	}
+
+	// Map all fields
+	data.ID = types.StringValue(publicKeyCred.Id)
+	data.ZoneID = types.StringValue(publicKeyCred.ZoneId)
+	data.Slug = types.StringValue(publicKeyCred.Slug)
+	data.OrganizationID = types.StringValue(publicKeyCred.OrganizationId)
+	data.CreatedAt = TimestampValue(publicKeyCred.CreatedAt)
+	data.UpdatedAt = TimestampValue(publicKeyCred.UpdatedAt)
+	data.ApplicationID = types.StringValue(publicKeyCred.ApplicationId)
+	data.JwksURI = types.StringValue(publicKeyCred.JwksUri)
+	data.Identifier = types.StringValue(publicKeyCred.Identifier)
+
+	return diags
+}
+
+// updateApplicationPublicKeyCredentialModelFromAPIResponse updates the model with data from the
+// ApplicationCredential API response (from Read operations).
+func updateApplicationPublicKeyCredentialModelFromAPIResponse(cred *client.ApplicationCredential, data *ApplicationPublicKeyCredentialModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// The response is a union type, we need to check which type we got
+	// For public key credentials, we expect ApplicationCredentialPublicKey
+	publicKeyCred, err := cred.AsApplicationCredentialPublicKey()
+	if err != nil {
+		diags.AddError("API Error", fmt.Sprintf("Expected public key credential type, got error: %s", err))
+		return diags
+	}
+
+	// Update all fields
+	data.ID = types.StringValue(publicKeyCred.Id)
+	data.ZoneID = types.StringValue(publicKeyCred.ZoneId)
+	data.Slug = types.StringValue(publicKeyCred.Slug)
+	data.OrganizationID = types.StringValue(publicKeyCred.OrganizationId)
+	data.CreatedAt = TimestampValue(publicKeyCred.CreatedAt)
+	data.UpdatedAt = TimestampValue(publicKeyCred.UpdatedAt)
+	data.ApplicationID = types.StringValue(publicKeyCred.ApplicationId)
+	data.JwksURI = types.StringValue(publicKeyCred.JwksUri)
+	data.Identifier = types.StringValue(publicKeyCred.Identifier)
+
+	return diags
+}
+
+// updateApplicationPublicCredentialModelFromCreateResponse updates the model with data from the
+// ApplicationCredentialCreateResponse. This function is called during Create.
+func updateApplicationPublicCredentialModelFromCreateResponse(cred *client.ApplicationCredentialCreateResponse, data *ApplicationPublicCredentialModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// The response is a union type, we need to check which type we got
+	// For public credentials, we expect ApplicationCredentialPublic
+	publicCred, err := cred.AsApplicationCredentialPublic()
+	if err != nil {
+		diags.AddError("API Error", fmt.Sprintf("Expected public credential response, got error: %s", err))
+		return diags
+	}
+
+	// Map all fields
+	data.ID = types.StringValue(publicCred.Id)
+	data.ZoneID = types.StringValue(publicCred.ZoneId)
+	data.Slug = types.StringValue(publicCred.Slug)
+	data.OrganizationID = types.StringValue(publicCred.OrganizationId)
+	data.CreatedAt = TimestampValue(publicCred.CreatedAt)
+	data.UpdatedAt = TimestampValue(publicCred.UpdatedAt)
+	data.ApplicationID = types.StringValue(publicCred.ApplicationId)
+	data.Identifier = types.StringValue(publicCred.Identifier)
+
+	return diags
+}
+
+// updateApplicationPublicCredentialModelFromAPIResponse updates the model with data from the
+// ApplicationCredential API response (from Read operations).
+func updateApplicationPublicCredentialModelFromAPIResponse(cred *client.ApplicationCredential, data *ApplicationPublicCredentialModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// The response is a union type, we need to check which type we got
+	// For public credentials, we expect ApplicationCredentialPublic
+	publicCred, err := cred.AsApplicationCredentialPublic()
+	if err != nil {
+		diags.AddError("API Error", fmt.Sprintf("Expected public credential type, got error: %s", err))
+		return diags
+	}
+
+	// Update all fields
+	data.ID = types.StringValue(publicCred.Id)
+	data.ZoneID = types.StringValue(publicCred.ZoneId)
+	data.Slug = types.StringValue(publicCred.Slug)
+	data.OrganizationID = types.StringValue(publicCred.OrganizationId)
+	data.CreatedAt = TimestampValue(publicCred.CreatedAt)
+	data.UpdatedAt = TimestampValue(publicCred.UpdatedAt)
+	data.ApplicationID = types.StringValue(publicCred.ApplicationId)
+	data.Identifier = types.StringValue(publicCred.Identifier)
+
+	return diags
+}
+
+// applicationCredentialType returns the type discriminator of an ApplicationCredential union.
+func applicationCredentialType(cred *client.ApplicationCredential) (string, error) {
+	raw, err := cred.MarshalJSON()
+	if err != nil {
+		return "", err
+	}
+
+	var discriminator struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(raw, &discriminator); err != nil {
+		return "", err
+	}
+
+	return discriminator.Type, nil
+}
+
+// isSupportedApplicationCredentialType reports whether the provider can map credentials of the given type.
+func isSupportedApplicationCredentialType(credType string) bool {
+	switch credType {
+	case string(client.ApplicationCredentialPasswordTypePassword),
+		string(client.ApplicationCredentialTokenTypeToken),
+		string(client.ApplicationCredentialPublicKeyTypePublicKey),
+		string(client.ApplicationCredentialUrlTypeUrl),
+		string(client.ApplicationCredentialPublicTypePublic):
+		return true
+	default:
+		return false
+	}
+}
+
+// updateApplicationCredentialSummaryModelFromAPIResponse maps any member of the ApplicationCredential
+// union to the ApplicationCredentialSummaryModel. Fields that do not apply to the credential's type are
+// set to null. Secrets are never mapped, even if the API includes them.
+func updateApplicationCredentialSummaryModelFromAPIResponse(cred *client.ApplicationCredential, data *ApplicationCredentialSummaryModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	credType, err := applicationCredentialType(cred)
+	if err != nil {
+		diags.AddError("API Error", fmt.Sprintf("Unable to determine application credential type, got error: %s", err))
+		return diags
+	}
+
+	data.Type = types.StringValue(credType)
+	data.ProviderID = types.StringNull()
+	data.Subject = types.StringNull()
+	data.JwksURI = types.StringNull()
+
+	switch credType {
+	case string(client.ApplicationCredentialPasswordTypePassword):
+		passwordCred, err := cred.AsApplicationCredentialPassword()
+		if err != nil {
+			diags.AddError("API Error", fmt.Sprintf("Expected password credential type, got error: %s", err))
+			return diags
+		}
+
+		data.ID = types.StringValue(passwordCred.Id)
+		data.ZoneID = types.StringValue(passwordCred.ZoneId)
+		data.Slug = types.StringValue(passwordCred.Slug)
+		data.OrganizationID = types.StringValue(passwordCred.OrganizationId)
+		data.CreatedAt = TimestampValue(passwordCred.CreatedAt)
+		data.UpdatedAt = TimestampValue(passwordCred.UpdatedAt)
+		data.ApplicationID = types.StringValue(passwordCred.ApplicationId)
+		data.Identifier = types.StringValue(passwordCred.Identifier)
+	case string(client.ApplicationCredentialTokenTypeToken):
+		tokenCred, err := cred.AsApplicationCredentialToken()
+		if err != nil {
+			diags.AddError("API Error", fmt.Sprintf("Expected token credential type, got error: %s", err))
+			return diags
+		}
+
+		data.ID = types.StringValue(tokenCred.Id)
+		data.ZoneID = types.StringValue(tokenCred.ZoneId)
+		data.Slug = types.StringValue(tokenCred.Slug)
+		data.OrganizationID = types.StringValue(tokenCred.OrganizationId)
+		data.CreatedAt = TimestampValue(tokenCred.CreatedAt)
+		data.UpdatedAt = TimestampValue(tokenCred.UpdatedAt)
+		data.ApplicationID = types.StringValue(tokenCred.ApplicationId)
+		data.Identifier = types.StringValue(tokenCred.Identifier)
+		data.ProviderID = types.StringValue(tokenCred.ProviderId)
+		data.Subject = NullableStringValue(tokenCred.Subject)
+	case string(client.ApplicationCredentialPublicKeyTypePublicKey):
+		publicKeyCred, err := cred.AsApplicationCredentialPublicKey()
+		if err != nil {
+			diags.AddError("API Error", fmt.Sprintf("Expected public key credential type, got error: %s", err))
+			return diags
+		}
+
+		data.ID = types.StringValue(publicKeyCred.Id)
+		data.ZoneID = types.StringValue(publicKeyCred.ZoneId)
+		data.Slug = types.StringValue(publicKeyCred.Slug)
+		data.OrganizationID = types.StringValue(publicKeyCred.OrganizationId)
+		data.CreatedAt = TimestampValue(publicKeyCred.CreatedAt)
+		data.UpdatedAt = TimestampValue(publicKeyCred.UpdatedAt)
+		data.ApplicationID = types.StringValue(publicKeyCred.ApplicationId)
+		data.Identifier = types.StringValue(publicKeyCred.Identifier)
+		data.JwksURI = types.StringValue(publicKeyCred.JwksUri)
+	case string(client.ApplicationCredentialUrlTypeUrl):
+		urlCred, err := cred.AsApplicationCredentialUrl()
+		if err != nil {
+			diags.AddError("API Error", fmt.Sprintf("Expected URL credential type, got error: %s", err))
+			return diags
+		}
+
+		data.ID = types.StringValue(urlCred.Id)
+		data.ZoneID = types.StringValue(urlCred.ZoneId)
+		data.Slug = types.StringValue(urlCred.Slug)
+		data.OrganizationID = types.StringValue(urlCred.OrganizationId)
+		data.CreatedAt = TimestampValue(urlCred.CreatedAt)
+		data.UpdatedAt = TimestampValue(urlCred.UpdatedAt)
+		data.ApplicationID = types.StringValue(urlCred.ApplicationId)
+		data.Identifier = types.StringValue(urlCred.Identifier)
+	case string(client.ApplicationCredentialPublicTypePublic):
+		publicCred, err := cred.AsApplicationCredentialPublic()
+		if err != nil {
+			diags.AddError("API Error", fmt.Sprintf("Expected public credential type, got error: %s", err))
+			return diags
+		}
+
+		data.ID = types.StringValue(publicCred.Id)
+		data.ZoneID = types.StringValue(publicCred.ZoneId)
+		data.Slug = types.StringValue(publicCred.Slug)
+		data.OrganizationID = types.StringValue(publicCred.OrganizationId)
+		data.CreatedAt = TimestampValue(publicCred.CreatedAt)
+		data.UpdatedAt = TimestampValue(publicCred.UpdatedAt)
+		data.ApplicationID = types.StringValue(publicCred.ApplicationId)
+		data.Identifier = types.StringValue(publicCred.Identifier)
+	default:
+		diags.AddError("API Error", fmt.Sprintf("Unsupported application credential type %q", credType))
+	}
+
+	return diags
+}
+
+// updateApplicationCredentialResourceModelFromAPIResponse maps any member of the ApplicationCredential
+// union to the ApplicationCredentialResourceModel. The settings of the credential's type are always
+// mapped, so that imported and moved credentials match their configuration. The client_secret is only
+// mapped when the API returns it on creation, and preserved from the existing state otherwise.
+func updateApplicationCredentialResourceModelFromAPIResponse(ctx context.Context, cred *client.ApplicationCredential, data *ApplicationCredentialResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	credType, err := applicationCredentialType(cred)
+	if err != nil {
+		diags.AddError("API Error", fmt.Sprintf("Unable to determine application credential type, got error: %s", err))
+		return diags
+	}
+
+	// Settings of the other types are always unset
+	token := types.ObjectNull(ApplicationCredentialTokenModel{}.AttributeTypes())
+	password := types.ObjectNull(ApplicationCredentialPasswordModel{}.AttributeTypes())
+	publicKey := types.ObjectNull(ApplicationCredentialPublicKeyModel{}.AttributeTypes())
+	url := types.ObjectNull(ApplicationCredentialURLModel{}.AttributeTypes())
+	public := types.ObjectNull(ApplicationCredentialPublicModel{}.AttributeTypes())
+	clientSecret := types.StringNull()
+
+	var base client.ApplicationCredentialBaseFields
+	var identifier string
+
+	switch credType {
+	case string(client.ApplicationCredentialTokenTypeToken):
+		tokenCred, err := cred.AsApplicationCredentialToken()
+		if err != nil {
+			diags.AddError("API Error", fmt.Sprintf("Expected token credential type, got error: %s", err))
+			return diags
+		}
+
+		base = client.ApplicationCredentialBaseFields{
+			Id:             tokenCred.Id,
+			ZoneId:         tokenCred.ZoneId,
+			Slug:           tokenCred.Slug,
+			OrganizationId: tokenCred.OrganizationId,
+			CreatedAt:      tokenCred.CreatedAt,
+			UpdatedAt:      tokenCred.UpdatedAt,
+			ApplicationId:  tokenCred.ApplicationId,
+		}
+		identifier = tokenCred.Identifier
+
+		obj, d := types.ObjectValueFrom(ctx, ApplicationCredentialTokenModel{}.AttributeTypes(), ApplicationCredentialTokenModel{
+			ProviderID: types.StringValue(tokenCred.ProviderId),
+			Subject:    NullableStringValue(tokenCred.Subject),
+		})
+		diags.Append(d...)
+		token = obj
+	case string(client.ApplicationCredentialPasswordTypePassword):
+		passwordCred, err := cred.AsApplicationCredentialPassword()
+		if err != nil {
+			diags.AddError("API Error", fmt.Sprintf("Expected password credential type, got error: %s", err))
+			return diags
+		}
+
+		base = client.ApplicationCredentialBaseFields{
+			Id:             passwordCred.Id,
+			ZoneId:         passwordCred.ZoneId,
+			Slug:           passwordCred.Slug,
+			OrganizationId: passwordCred.OrganizationId,
+			CreatedAt:      passwordCred.CreatedAt,
+			UpdatedAt:      passwordCred.UpdatedAt,
+			ApplicationId:  passwordCred.ApplicationId,
+		}
+		identifier = passwordCred.Identifier
+
+		obj, d := types.ObjectValueFrom(ctx, ApplicationCredentialPasswordModel{}.AttributeTypes(), ApplicationCredentialPasswordModel{
+			Identifier: types.StringValue(passwordCred.Identifier),
+		})
+		diags.Append(d...)
+		password = obj
+
+		// Password is only returned on creation
+		if passwordCred.Password != nil {
+			clientSecret = types.StringPointerValue(passwordCred.Password)
+		} else if !data.ClientSecret.IsUnknown() {
+			clientSecret = data.ClientSecret
+		}
+	case string(client.ApplicationCredentialPublicKeyTypePublicKey):
+		publicKeyCred, err := cred.AsApplicationCredentialPublicKey()
+		if err != nil {
+			diags.AddError("API Error", fmt.Sprintf("Expected public key credential type, got error: %s", err))
+			return diags
+		}
+
+		base = client.ApplicationCredentialBaseFields{
+			Id:             publicKeyCred.Id,
+			ZoneId:         publicKeyCred.ZoneId,
+			Slug:           publicKeyCred.Slug,
+			OrganizationId: publicKeyCred.OrganizationId,
+			CreatedAt:      publicKeyCred.CreatedAt,
+			UpdatedAt:      publicKeyCred.UpdatedAt,
+			ApplicationId:  publicKeyCred.ApplicationId,
+		}
+		identifier = publicKeyCred.Identifier
+
+		obj, d := types.ObjectValueFrom(ctx, ApplicationCredentialPublicKeyModel{}.AttributeTypes(), ApplicationCredentialPublicKeyModel{
+			JwksURI:    types.StringValue(publicKeyCred.JwksUri),
+			Identifier: types.StringValue(publicKeyCred.Identifier),
+		})
+		diags.Append(d...)
+		publicKey = obj
+	case string(client.ApplicationCredentialUrlTypeUrl):
+		urlCred, err := cred.AsApplicationCredentialUrl()
+		if err != nil {
+			diags.AddError("API Error", fmt.Sprintf("Expected URL credential type, got error: %s", err))
+			return diags
+		}
+
+		base = client.ApplicationCredentialBaseFields{
+			Id:             urlCred.Id,
+			ZoneId:         urlCred.ZoneId,
+			Slug:           urlCred.Slug,
+			OrganizationId: urlCred.OrganizationId,
+			CreatedAt:      urlCred.CreatedAt,
+			UpdatedAt:      urlCred.UpdatedAt,
+			ApplicationId:  urlCred.ApplicationId,
+		}
+		identifier = urlCred.Identifier
+
+		obj, d := types.ObjectValueFrom(ctx, ApplicationCredentialURLModel{}.AttributeTypes(), ApplicationCredentialURLModel{
+			URL: types.StringValue(urlCred.Identifier),
+		})
+		diags.Append(d...)
+		url = obj
+	case string(client.ApplicationCredentialPublicTypePublic):
+		publicCred, err := cred.AsApplicationCredentialPublic()
+		if err != nil {
+			diags.AddError("API Error", fmt.Sprintf("Expected public credential type, got error: %s", err))
+			return diags
+		}
+
+		base = client.ApplicationCredentialBaseFields{
+			Id:             publicCred.Id,
+			ZoneId:         publicCred.ZoneId,
+			Slug:           publicCred.Slug,
+			OrganizationId: publicCred.OrganizationId,
+			CreatedAt:      publicCred.CreatedAt,
+			UpdatedAt:      publicCred.UpdatedAt,
+			ApplicationId:  publicCred.ApplicationId,
+		}
+		identifier = publicCred.Identifier
+
+		obj, d := types.ObjectValueFrom(ctx, ApplicationCredentialPublicModel{}.AttributeTypes(), ApplicationCredentialPublicModel{
+			Identifier: types.StringValue(publicCred.Identifier),
+		})
+		diags.Append(d...)
+		public = obj
+	default:
+		diags.AddError("API Error", fmt.Sprintf("Unsupported application credential type %q", credType))
+		return diags
+	}
+
+	if diags.HasError() {
+		return diags
+	}
+
+	data.ID = types.StringValue(base.Id)
+	data.ZoneID = types.StringValue(base.ZoneId)
+	data.Slug = types.StringValue(base.Slug)
+	data.OrganizationID = types.StringValue(base.OrganizationId)
+	data.CreatedAt = TimestampValue(base.CreatedAt)
+	data.UpdatedAt = TimestampValue(base.UpdatedAt)
+	data.ApplicationID = types.StringValue(base.ApplicationId)
+	data.Type = types.StringValue(credType)
+	data.Identifier = types.StringValue(identifier)
+	data.ClientSecret = clientSecret
+	data.Token = token
+	data.Password = password
+	data.PublicKey = publicKey
+	data.URL = url
+	data.Public = public
+
+	return diags
+}
+
 // GetOrganizationID retrieves the organization ID from the API using ListOrganizations.
 // Service account credentials are scoped to a single organization, so this returns the
 // one organization the credentials have access to.
 func GetOrganizationID(ctx context.Context, apiClient *client.ClientWithResponses) (string, error) {
-	listOrgsParams := client.ListOrganizationsParams{}
+	thisOrg, err := GetOrganization(ctx, apiClient, nil)
+	if err != nil {
+		return "", err
+	}
+
+	if thisOrg.Id == nil {
+		return "", fmt.Errorf("missing organization ID")
+	}
+
+	return *thisOrg.Id, nil
+}
+
+// GetOrganization retrieves the organization the credentials have access to using ListOrganizations,
+// optionally selecting it by slug.
+func GetOrganization(ctx context.Context, apiClient *client.ClientWithResponses, slug *string) (*client.Organization, error) {
+	listOrgsParams := client.ListOrganizationsParams{
+		Slug: slug,
+	}
 
 	orgResp, err := apiClient.ListOrganizationsWithResponse(ctx, &listOrgsParams)
 	if err != nil {
-		return "", fmt.Errorf("failed to list organizations: %w", err)
+		return nil, fmt.Errorf("failed to list organizations: %w", err)
 	}
 
 	if orgResp.StatusCode() != 200 {
-		return "", fmt.Errorf("failed to list organizations: status %d", orgResp.StatusCode())
+		return nil, fmt.Errorf("failed to list organizations: status %d", orgResp.StatusCode())
 	}
 
 	if orgResp.JSON200 == nil {
-		return "", fmt.Errorf("unable to list organizations: no response body")
+		return nil, fmt.Errorf("unable to list organizations: no response body")
 	}
 
 	if len(orgResp.JSON200.Items) != 1 {
-		return "", fmt.Errorf("unexpected number of organizations: %d", len(orgResp.JSON200.Items))
-	}
-
-	thisOrg := orgResp.JSON200.Items[0]
-	if thisOrg.Id == nil {
-		return "", fmt.Errorf("missing organization ID")
+		return nil, fmt.Errorf("unexpected number of organizations: %d", len(orgResp.JSON200.Items))
 	}
 
-	return *thisOrg.Id, nil
+	return &orgResp.JSON200.Items[0], nil
 }
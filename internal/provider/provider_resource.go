@@ -5,13 +5,16 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -22,8 +25,10 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &ProviderResource{}
-	_ resource.ResourceWithImportState = &ProviderResource{}
+	_ resource.Resource                   = &ProviderResource{}
+	_ resource.ResourceWithImportState    = &ProviderResource{}
+	_ resource.ResourceWithValidateConfig = &ProviderResource{}
+	_ resource.ResourceWithModifyPlan     = &ProviderResource{}
 )
 
 func NewProviderResource() resource.Resource {
@@ -37,36 +42,69 @@ type ProviderResource struct {
 
 // ProviderResourceModel describes the resource data model.
 type ProviderResourceModel struct {
-	ID           types.String `tfsdk:"id"`
-	ZoneID       types.String `tfsdk:"zone_id"`
-	Name         types.String `tfsdk:"name"`
-	Description  types.String `tfsdk:"description"`
-	Identifier   types.String `tfsdk:"identifier"`
-	ClientID     types.String `tfsdk:"client_id"`
-	ClientSecret types.String `tfsdk:"client_secret"`
-	OAuth2       types.Object `tfsdk:"oauth2"`
+	ID             types.String `tfsdk:"id"`
+	ZoneID         types.String `tfsdk:"zone_id"`
+	Slug           types.String `tfsdk:"slug"`
+	OrganizationID types.String `tfsdk:"organization_id"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+	UpdatedAt      types.String `tfsdk:"updated_at"`
+	Name           types.String `tfsdk:"name"`
+	Description    types.String `tfsdk:"description"`
+	Identifier     types.String `tfsdk:"identifier"`
+	ClientID       types.String `tfsdk:"client_id"`
+	ClientSecret   types.String `tfsdk:"client_secret"`
+	Type           types.String `tfsdk:"type"`
+	OAuth2         types.Object `tfsdk:"oauth2"`
+	OpenID         types.Object `tfsdk:"openid"`
+	Discovery      types.Object `tfsdk:"discovery"`
 }
 
 // OAuth2ProviderModel describes the nested oauth2 block data model.
 type OAuth2ProviderModel struct {
-	AuthorizationEndpoint types.String `tfsdk:"authorization_endpoint"`
-	TokenEndpoint         types.String `tfsdk:"token_endpoint"`
+	AuthorizationEndpoint         types.String `tfsdk:"authorization_endpoint"`
+	TokenEndpoint                 types.String `tfsdk:"token_endpoint"`
+	RegistrationEndpoint          types.String `tfsdk:"registration_endpoint"`
+	JwksURI                       types.String `tfsdk:"jwks_uri"`
+	CodeChallengeMethodsSupported types.Set    `tfsdk:"code_challenge_methods_supported"`
+	ScopesSupported               types.Set    `tfsdk:"scopes_supported"`
 }
 
 func (m OAuth2ProviderModel) AttributeTypes() map[string]attr.Type {
 	return map[string]attr.Type{
-		"authorization_endpoint": types.StringType,
-		"token_endpoint":         types.StringType,
+		"authorization_endpoint":           types.StringType,
+		"token_endpoint":                   types.StringType,
+		"registration_endpoint":            types.StringType,
+		"jwks_uri":                         types.StringType,
+		"code_challenge_methods_supported": types.SetType{ElemType: types.StringType},
+		"scopes_supported":                 types.SetType{ElemType: types.StringType},
 	}
 }
 
+// OpenIDProviderModel describes the nested openid block data model.
+type OpenIDProviderModel struct {
+	UserinfoEndpoint types.String `tfsdk:"userinfo_endpoint"`
+}
+
+func (m OpenIDProviderModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"userinfo_endpoint": types.StringType,
+	}
+}
+
+// isKeycardManagedProviderType reports whether providers of the given type are built into
+// every zone and managed by Keycard rather than configured against an external server.
+func isKeycardManagedProviderType(providerType string) bool {
+	return providerType == string(client.ProviderTypeKeycardVault) || providerType == string(client.ProviderTypeKeycardSts)
+}
+
 func (r *ProviderResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_provider"
 }
 
 func (r *ProviderResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages a Keycard provider. A provider is a system that supplies access to resources and allows actors (users or applications) to authenticate.",
+		MarkdownDescription: "Manages a Keycard provider. A provider is a system that supplies access to resources and allows actors (users or applications) to authenticate. " +
+			"The Keycard-managed `keycard-vault` and `keycard-sts` providers are built into every zone; they cannot be created or deleted, but can be adopted with `terraform import`.",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -76,6 +114,31 @@ func (r *ProviderResource) Schema(ctx context.Context, req resource.SchemaReques
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"slug": schema.StringAttribute{
+				MarkdownDescription: "URL-safe identifier of the provider, unique within the zone.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "The organization that owns the provider.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The time the provider was created, in RFC 3339 format.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "The time the provider was last updated, in RFC 3339 format.",
+				Computed:            true,
+			},
 			"zone_id": schema.StringAttribute{
 				MarkdownDescription: "The zone this provider belongs to. Changing this will replace the provider.",
 				Required:            true,
@@ -113,8 +176,24 @@ func (r *ProviderResource) Schema(ctx context.Context, req resource.SchemaReques
 					stringvalidator.LengthAtLeast(1),
 				},
 			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the provider. One of `external`, `keycard-vault` or `keycard-sts`. New providers are always `external`; " +
+					"the Keycard-managed types can only be imported, and do not accept `client_secret`, `oauth2` or `openid` configuration. Defaults to the type reported by the API.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(client.ProviderTypeExternal),
+						string(client.ProviderTypeKeycardVault),
+						string(client.ProviderTypeKeycardSts),
+					),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"oauth2": schema.SingleNestedAttribute{
-				MarkdownDescription: "OAuth 2.0 protocol configuration.",
+				MarkdownDescription: "OAuth 2.0 protocol configuration. Attributes removed from the configuration are unset on the provider.",
 				Optional:            true,
 				Computed:            true,
 				Attributes: map[string]schema.Attribute{
@@ -140,6 +219,94 @@ func (r *ProviderResource) Schema(ctx context.Context, req resource.SchemaReques
 							stringplanmodifier.UseStateForUnknown(),
 						},
 					},
+					"registration_endpoint": schema.StringAttribute{
+						MarkdownDescription: "OAuth 2.0 Dynamic Client Registration endpoint URL.",
+						Optional:            true,
+						Computed:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"jwks_uri": schema.StringAttribute{
+						MarkdownDescription: "JSON Web Key Set URL used to validate tokens issued by the provider.",
+						Optional:            true,
+						Computed:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"code_challenge_methods_supported": schema.SetAttribute{
+						MarkdownDescription: "PKCE code challenge methods supported by the provider (e.g., `S256`).",
+						ElementType:         types.StringType,
+						Optional:            true,
+						Computed:            true,
+						Validators: []validator.Set{
+							setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+						},
+						PlanModifiers: []planmodifier.Set{
+							setplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"scopes_supported": schema.SetAttribute{
+						MarkdownDescription: "OAuth 2.0 scopes supported by the provider.",
+						ElementType:         types.StringType,
+						Optional:            true,
+						Computed:            true,
+						Validators: []validator.Set{
+							setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+						},
+						PlanModifiers: []planmodifier.Set{
+							setplanmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"discovery": schema.SingleNestedAttribute{
+				MarkdownDescription: "Discover the provider's endpoints from its metadata document at plan time. " +
+					"Discovered values fill in the `authorization_endpoint`, `token_endpoint`, `registration_endpoint`, `jwks_uri` and `scopes_supported` attributes of `oauth2` " +
+					"and the `userinfo_endpoint` attribute of `openid`. Attributes set explicitly take precedence over discovered values. " +
+					"Set to an empty object (`discovery = {}`) to enable discovery with the default settings.",
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"document": schema.StringAttribute{
+						MarkdownDescription: "The metadata document to discover the provider from. Either `openid-configuration`, fetched from `{identifier}/.well-known/openid-configuration`, " +
+							"or `oauth-authorization-server`, the RFC 8414 OAuth 2.0 Authorization Server Metadata. " +
+							"When not set, the OpenID Connect document is tried first, falling back to the OAuth 2.0 Authorization Server Metadata.",
+						Optional: true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(
+								discoveryDocumentOpenIDConfiguration,
+								discoveryDocumentOAuthAuthorizationServer,
+							),
+						},
+					},
+				},
+			},
+			"openid": schema.SingleNestedAttribute{
+				MarkdownDescription: "OpenID Connect protocol configuration. Attributes removed from the configuration are unset on the provider.",
+				Optional:            true,
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"userinfo_endpoint": schema.StringAttribute{
+						MarkdownDescription: "OpenID Connect UserInfo endpoint URL.",
+						Optional:            true,
+						Computed:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
 				},
 				PlanModifiers: []planmodifier.Object{
 					objectplanmodifier.UseStateForUnknown(),
@@ -169,6 +336,189 @@ func (r *ProviderResource) Configure(ctx context.Context, req resource.Configure
 	r.client = client
 }
 
+func (r *ProviderResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ProviderResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Type.IsNull() || data.Type.IsUnknown() || !isKeycardManagedProviderType(data.Type.ValueString()) {
+		return
+	}
+
+	// Keycard-managed providers are configured by Keycard itself
+	if !data.ClientSecret.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("client_secret"),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("client_secret cannot be set for %s providers, their credentials are managed by Keycard.", data.Type.ValueString()),
+		)
+	}
+
+	if !data.OAuth2.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("oauth2"),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("oauth2 cannot be set for %s providers, their endpoints are managed by Keycard.", data.Type.ValueString()),
+		)
+	}
+
+	if !data.OpenID.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("openid"),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("openid cannot be set for %s providers, their endpoints are managed by Keycard.", data.Type.ValueString()),
+		)
+	}
+
+	if !data.Discovery.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("discovery"),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("discovery cannot be set for %s providers, their endpoints are managed by Keycard.", data.Type.ValueString()),
+		)
+	}
+}
+
+func (r *ProviderResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when the provider is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	r.planUnsetProtocols(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.planDiscoveredProtocols(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var planType types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("type"), &planType)...)
+	if resp.Diagnostics.HasError() || planType.IsNull() || planType.IsUnknown() {
+		return
+	}
+
+	if req.State.Raw.IsNull() {
+		// Only external providers can be created, the Keycard-managed ones already exist in every zone
+		if isKeycardManagedProviderType(planType.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("type"),
+				"Unsupported Provider Type",
+				fmt.Sprintf("Providers of type %s are built into every zone and cannot be created. Use terraform import to manage the existing provider.", planType.ValueString()),
+			)
+		}
+		return
+	}
+
+	var stateType types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("type"), &stateType)...)
+	if resp.Diagnostics.HasError() || stateType.IsNull() {
+		return
+	}
+
+	if !planType.Equal(stateType) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("type"),
+			"Provider Type Cannot Be Changed",
+			fmt.Sprintf("The type of an existing provider cannot be changed from %s to %s.", stateType.ValueString(), planType.ValueString()),
+		)
+	}
+}
+
+// configuredStringValue returns null for an attribute that is not set in the configuration, and the planned value otherwise.
+func configuredStringValue(configValue, planValue types.String) types.String {
+	if configValue.IsNull() {
+		return types.StringNull()
+	}
+
+	return planValue
+}
+
+// configuredSetValue returns null for an attribute that is not set in the configuration, and the planned value otherwise.
+func configuredSetValue(configValue, planValue types.Set) types.Set {
+	if configValue.IsNull() {
+		return types.SetNull(types.StringType)
+	}
+
+	return planValue
+}
+
+// planUnsetProtocols plans null for the oauth2 and openid attributes of an existing provider that are
+// not set in the configuration, so that removing them from the configuration unsets them on update.
+// The protocols of Keycard-managed providers are configured by Keycard and keep their state values.
+func (r *ProviderResource) planUnsetProtocols(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var config, plan, state ProviderResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if isKeycardManagedProviderType(state.Type.ValueString()) {
+		return
+	}
+
+	switch {
+	case config.OAuth2.IsNull():
+		plan.OAuth2 = types.ObjectNull(OAuth2ProviderModel{}.AttributeTypes())
+	case !config.OAuth2.IsUnknown() && !plan.OAuth2.IsNull() && !plan.OAuth2.IsUnknown():
+		var configOAuth2, planOAuth2 OAuth2ProviderModel
+		resp.Diagnostics.Append(config.OAuth2.As(ctx, &configOAuth2, basetypes.ObjectAsOptions{})...)
+		resp.Diagnostics.Append(plan.OAuth2.As(ctx, &planOAuth2, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		planOAuth2.AuthorizationEndpoint = configuredStringValue(configOAuth2.AuthorizationEndpoint, planOAuth2.AuthorizationEndpoint)
+		planOAuth2.TokenEndpoint = configuredStringValue(configOAuth2.TokenEndpoint, planOAuth2.TokenEndpoint)
+		planOAuth2.RegistrationEndpoint = configuredStringValue(configOAuth2.RegistrationEndpoint, planOAuth2.RegistrationEndpoint)
+		planOAuth2.JwksURI = configuredStringValue(configOAuth2.JwksURI, planOAuth2.JwksURI)
+		planOAuth2.CodeChallengeMethodsSupported = configuredSetValue(configOAuth2.CodeChallengeMethodsSupported, planOAuth2.CodeChallengeMethodsSupported)
+		planOAuth2.ScopesSupported = configuredSetValue(configOAuth2.ScopesSupported, planOAuth2.ScopesSupported)
+
+		oauth2Obj, diags := types.ObjectValueFrom(ctx, planOAuth2.AttributeTypes(), planOAuth2)
+		resp.Diagnostics.Append(diags...)
+		plan.OAuth2 = oauth2Obj
+	}
+
+	switch {
+	case config.OpenID.IsNull():
+		plan.OpenID = types.ObjectNull(OpenIDProviderModel{}.AttributeTypes())
+	case !config.OpenID.IsUnknown() && !plan.OpenID.IsNull() && !plan.OpenID.IsUnknown():
+		var configOpenID, planOpenID OpenIDProviderModel
+		resp.Diagnostics.Append(config.OpenID.As(ctx, &configOpenID, basetypes.ObjectAsOptions{})...)
+		resp.Diagnostics.Append(plan.OpenID.As(ctx, &planOpenID, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		planOpenID.UserinfoEndpoint = configuredStringValue(configOpenID.UserinfoEndpoint, planOpenID.UserinfoEndpoint)
+
+		openIDObj, diags := types.ObjectValueFrom(ctx, planOpenID.AttributeTypes(), planOpenID)
+		resp.Diagnostics.Append(diags...)
+		plan.OpenID = openIDObj
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("oauth2"), plan.OAuth2)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("openid"), plan.OpenID)...)
+}
+
 func (r *ProviderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data ProviderResourceModel
 
@@ -202,31 +552,13 @@ func (r *ProviderResource) Create(ctx context.Context, req resource.CreateReques
 		createReq.ClientSecret = &clientSecret
 	}
 
-	// Set protocols.oauth2 fields if oauth2 block is provided
-	if !data.OAuth2.IsNull() && !data.OAuth2.IsUnknown() {
-		var oauth2Data OAuth2ProviderModel
-		diags := data.OAuth2.As(ctx, &oauth2Data, basetypes.ObjectAsOptions{})
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-
-		// Only set protocols if at least one endpoint is provided
-		if (!oauth2Data.AuthorizationEndpoint.IsNull() && !oauth2Data.AuthorizationEndpoint.IsUnknown()) ||
-			(!oauth2Data.TokenEndpoint.IsNull() && !oauth2Data.TokenEndpoint.IsUnknown()) {
-
-			createReq.Protocols = &client.ProviderProtocolCreate{
-				Oauth2: &client.ProviderOAuth2ProtocolCreate{},
-			}
-			if !oauth2Data.AuthorizationEndpoint.IsNull() && !oauth2Data.AuthorizationEndpoint.IsUnknown() {
-				createReq.Protocols.Oauth2.AuthorizationEndpoint = oauth2Data.AuthorizationEndpoint.ValueStringPointer()
-			}
-
-			if !oauth2Data.TokenEndpoint.IsNull() && !oauth2Data.TokenEndpoint.IsUnknown() {
-				createReq.Protocols.Oauth2.TokenEndpoint = oauth2Data.TokenEndpoint.ValueStringPointer()
-			}
-		}
+	// Set protocols from the oauth2 and openid blocks if provided
+	protocols, diags := providerProtocolCreateFromModel(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	createReq.Protocols = protocols
 
 	// Create the provider
 	createResp, err := r.client.CreateProviderWithResponse(ctx, data.ZoneID.ValueString(), createReq)
@@ -341,41 +673,20 @@ func (r *ProviderResource) Update(ctx context.Context, req resource.UpdateReques
 		updateReq.ClientId = StringValueNullable(data.ClientID)
 	}
 
-	// Set client_secret at root level
-	if !data.ClientSecret.IsUnknown() {
-		updateReq.ClientSecret = StringValueNullable(data.ClientSecret)
-	}
-
-	// Set protocols.oauth2 fields
-	// Handle both null (to clear) and non-null (to set) values
-	if !data.OAuth2.IsUnknown() {
-		if data.OAuth2.IsNull() {
-			// Explicitly clear protocols to allow server defaults
-			updateReq.Protocols = nullable.NewNullNullable[client.ProviderProtocolUpdate]()
-		} else {
-			var oauth2Data OAuth2ProviderModel
-			diags := data.OAuth2.As(ctx, &oauth2Data, basetypes.ObjectAsOptions{})
-			resp.Diagnostics.Append(diags...)
-			if resp.Diagnostics.HasError() {
-				return
-			}
+	// Keycard manages the secret and endpoints of its built-in providers, so they are left untouched
+	if !isKeycardManagedProviderType(data.Type.ValueString()) {
+		// Set client_secret at root level
+		if !data.ClientSecret.IsUnknown() {
+			updateReq.ClientSecret = StringValueNullable(data.ClientSecret)
+		}
 
-			// Only set protocols if at least one endpoint is provided
-			if !oauth2Data.AuthorizationEndpoint.IsUnknown() || !oauth2Data.TokenEndpoint.IsUnknown() {
-				oauth2Update := client.ProviderOAuth2ProtocolUpdate{}
-				if !oauth2Data.AuthorizationEndpoint.IsNull() && !oauth2Data.AuthorizationEndpoint.IsUnknown() {
-					oauth2Update.AuthorizationEndpoint = StringValueNullable(oauth2Data.AuthorizationEndpoint)
-				}
-
-				if !oauth2Data.TokenEndpoint.IsNull() && !oauth2Data.TokenEndpoint.IsUnknown() {
-					oauth2Update.TokenEndpoint = StringValueNullable(oauth2Data.TokenEndpoint)
-				}
-				protocolUpdate := client.ProviderProtocolUpdate{
-					Oauth2: nullable.NewNullableWithValue(oauth2Update),
-				}
-				updateReq.Protocols = nullable.NewNullableWithValue(protocolUpdate)
-			}
+		// Set protocols, sending null for unset attributes to remove them
+		protocols, diags := providerProtocolUpdateFromModel(ctx, data)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
+		updateReq.Protocols = protocols
 	}
 
 	// Update the provider
@@ -409,6 +720,143 @@ func (r *ProviderResource) Update(ctx context.Context, req resource.UpdateReques
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// providerProtocolCreateFromModel builds the protocols of a provider create request from the
+// oauth2 and openid blocks. It returns nil when neither block configures any attribute.
+func providerProtocolCreateFromModel(ctx context.Context, data ProviderResourceModel) (*client.ProviderProtocolCreate, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var protocols client.ProviderProtocolCreate
+
+	if !data.OAuth2.IsNull() && !data.OAuth2.IsUnknown() {
+		var oauth2Data OAuth2ProviderModel
+		diags.Append(data.OAuth2.As(ctx, &oauth2Data, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		oauth2Create := client.ProviderOAuth2ProtocolCreate{}
+		hasOAuth2 := false
+
+		if !oauth2Data.AuthorizationEndpoint.IsNull() && !oauth2Data.AuthorizationEndpoint.IsUnknown() {
+			oauth2Create.AuthorizationEndpoint = oauth2Data.AuthorizationEndpoint.ValueStringPointer()
+			hasOAuth2 = true
+		}
+
+		if !oauth2Data.TokenEndpoint.IsNull() && !oauth2Data.TokenEndpoint.IsUnknown() {
+			oauth2Create.TokenEndpoint = oauth2Data.TokenEndpoint.ValueStringPointer()
+			hasOAuth2 = true
+		}
+
+		if !oauth2Data.RegistrationEndpoint.IsNull() && !oauth2Data.RegistrationEndpoint.IsUnknown() {
+			oauth2Create.RegistrationEndpoint = oauth2Data.RegistrationEndpoint.ValueStringPointer()
+			hasOAuth2 = true
+		}
+
+		if !oauth2Data.JwksURI.IsNull() && !oauth2Data.JwksURI.IsUnknown() {
+			oauth2Create.JwksUri = oauth2Data.JwksURI.ValueStringPointer()
+			hasOAuth2 = true
+		}
+
+		if !oauth2Data.CodeChallengeMethodsSupported.IsNull() && !oauth2Data.CodeChallengeMethodsSupported.IsUnknown() {
+			var methods []string
+			diags.Append(oauth2Data.CodeChallengeMethodsSupported.ElementsAs(ctx, &methods, false)...)
+			oauth2Create.CodeChallengeMethodsSupported = &methods
+			hasOAuth2 = true
+		}
+
+		if !oauth2Data.ScopesSupported.IsNull() && !oauth2Data.ScopesSupported.IsUnknown() {
+			var scopes []string
+			diags.Append(oauth2Data.ScopesSupported.ElementsAs(ctx, &scopes, false)...)
+			oauth2Create.ScopesSupported = &scopes
+			hasOAuth2 = true
+		}
+
+		// Only set oauth2 if at least one attribute is provided
+		if hasOAuth2 {
+			protocols.Oauth2 = &oauth2Create
+		}
+	}
+
+	if !data.OpenID.IsNull() && !data.OpenID.IsUnknown() {
+		var openIDData OpenIDProviderModel
+		diags.Append(data.OpenID.As(ctx, &openIDData, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		if !openIDData.UserinfoEndpoint.IsNull() && !openIDData.UserinfoEndpoint.IsUnknown() {
+			protocols.Openid = &client.ProviderOpenIDProtocolCreate{
+				UserinfoEndpoint: openIDData.UserinfoEndpoint.ValueStringPointer(),
+			}
+		}
+	}
+
+	if protocols.Oauth2 == nil && protocols.Openid == nil {
+		return nil, diags
+	}
+
+	return &protocols, diags
+}
+
+// providerProtocolUpdateFromModel builds the protocols of a provider update request from the
+// oauth2 and openid blocks. Null blocks and attributes are sent as null to remove them from
+// the provider, while unknown ones are left out so the API keeps their current values.
+func providerProtocolUpdateFromModel(ctx context.Context, data ProviderResourceModel) (nullable.Nullable[client.ProviderProtocolUpdate], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if data.OAuth2.IsUnknown() && data.OpenID.IsUnknown() {
+		return nullable.Nullable[client.ProviderProtocolUpdate]{}, diags
+	}
+
+	// Explicitly clear protocols to allow server defaults
+	if data.OAuth2.IsNull() && data.OpenID.IsNull() {
+		return nullable.NewNullNullable[client.ProviderProtocolUpdate](), diags
+	}
+
+	protocolUpdate := client.ProviderProtocolUpdate{}
+
+	switch {
+	case data.OAuth2.IsNull():
+		protocolUpdate.Oauth2 = nullable.NewNullNullable[client.ProviderOAuth2ProtocolUpdate]()
+	case !data.OAuth2.IsUnknown():
+		var oauth2Data OAuth2ProviderModel
+		diags.Append(data.OAuth2.As(ctx, &oauth2Data, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nullable.Nullable[client.ProviderProtocolUpdate]{}, diags
+		}
+
+		codeChallengeMethods, setDiags := StringSetValueNullable(ctx, oauth2Data.CodeChallengeMethodsSupported)
+		diags.Append(setDiags...)
+		scopes, setDiags := StringSetValueNullable(ctx, oauth2Data.ScopesSupported)
+		diags.Append(setDiags...)
+
+		protocolUpdate.Oauth2 = nullable.NewNullableWithValue(client.ProviderOAuth2ProtocolUpdate{
+			AuthorizationEndpoint:         StringValueNullable(oauth2Data.AuthorizationEndpoint),
+			TokenEndpoint:                 StringValueNullable(oauth2Data.TokenEndpoint),
+			RegistrationEndpoint:          StringValueNullable(oauth2Data.RegistrationEndpoint),
+			JwksUri:                       StringValueNullable(oauth2Data.JwksURI),
+			CodeChallengeMethodsSupported: codeChallengeMethods,
+			ScopesSupported:               scopes,
+		})
+	}
+
+	switch {
+	case data.OpenID.IsNull():
+		protocolUpdate.Openid = nullable.NewNullNullable[client.ProviderOpenIDProtocolUpdate]()
+	case !data.OpenID.IsUnknown():
+		var openIDData OpenIDProviderModel
+		diags.Append(data.OpenID.As(ctx, &openIDData, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nullable.Nullable[client.ProviderProtocolUpdate]{}, diags
+		}
+
+		protocolUpdate.Openid = nullable.NewNullableWithValue(client.ProviderOpenIDProtocolUpdate{
+			UserinfoEndpoint: StringValueNullable(openIDData.UserinfoEndpoint),
+		})
+	}
+
+	return nullable.NewNullableWithValue(protocolUpdate), diags
+}
+
 func (r *ProviderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var data ProviderResourceModel
 
@@ -419,6 +867,15 @@ func (r *ProviderResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	// Keycard-managed providers live as long as their zone, so they are only removed from state
+	if isKeycardManagedProviderType(data.Type.ValueString()) {
+		resp.Diagnostics.AddWarning(
+			"Provider Not Deleted",
+			fmt.Sprintf("Provider %s is a %s provider managed by Keycard and cannot be deleted. It has been removed from Terraform state only.", data.ID.ValueString(), data.Type.ValueString()),
+		)
+		return
+	}
+
 	// Delete the provider
 	deleteResp, err := r.client.DeleteProviderWithResponse(ctx, data.ZoneID.ValueString(), data.ID.ValueString())
 	if err != nil {
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
@@ -25,7 +26,12 @@ func TestAccProviderResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("keycard_provider.test", "name", rName),
 					resource.TestCheckResourceAttr("keycard_provider.test", "identifier", identifier),
 					resource.TestCheckResourceAttrSet("keycard_provider.test", "id"),
+					resource.TestCheckResourceAttrSet("keycard_provider.test", "slug"),
+					resource.TestCheckResourceAttrSet("keycard_provider.test", "organization_id"),
+					resource.TestCheckResourceAttrSet("keycard_provider.test", "created_at"),
+					resource.TestCheckResourceAttrSet("keycard_provider.test", "updated_at"),
 					resource.TestCheckResourceAttrSet("keycard_provider.test", "zone_id"),
+					resource.TestCheckResourceAttr("keycard_provider.test", "type", "external"),
 				),
 			},
 			// ImportState testing
@@ -147,20 +153,101 @@ func TestAccProviderResource_oauth2Updates(t *testing.T) {
 					resource.TestCheckResourceAttr("keycard_provider.test", "oauth2.token_endpoint", identifier+"/token"),
 				),
 			},
-			// Update back to basic (remove optional fields)
+			// Update back to basic (remove optional fields, including the oauth2 endpoints)
 			{
 				Config: testAccProviderResourceConfig_basic(rName, identifier),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("keycard_provider.test", "identifier", identifier),
 					resource.TestCheckNoResourceAttr("keycard_provider.test", "client_id"),
-					resource.TestCheckResourceAttrSet("keycard_provider.test", "oauth2.authorization_endpoint"),
-					resource.TestCheckResourceAttrSet("keycard_provider.test", "oauth2.token_endpoint"),
+					resource.TestCheckNoResourceAttr("keycard_provider.test", "oauth2.authorization_endpoint"),
+					resource.TestCheckNoResourceAttr("keycard_provider.test", "oauth2.token_endpoint"),
 				),
 			},
 		},
 	})
 }
 
+func TestAccProviderResource_fullProtocols(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tftest")
+	identifier := fmt.Sprintf("https://%s.example.com", rName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with every OAuth2 and OpenID protocol attribute
+			{
+				Config: testAccProviderResourceConfig_fullProtocols(rName, identifier, "/keys", "profile"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("keycard_provider.test", "oauth2.authorization_endpoint", identifier+"/authorize"),
+					resource.TestCheckResourceAttr("keycard_provider.test", "oauth2.token_endpoint", identifier+"/token"),
+					resource.TestCheckResourceAttr("keycard_provider.test", "oauth2.registration_endpoint", identifier+"/register"),
+					resource.TestCheckResourceAttr("keycard_provider.test", "oauth2.jwks_uri", identifier+"/keys"),
+					resource.TestCheckResourceAttr("keycard_provider.test", "oauth2.code_challenge_methods_supported.#", "1"),
+					resource.TestCheckTypeSetElemAttr("keycard_provider.test", "oauth2.code_challenge_methods_supported.*", "S256"),
+					resource.TestCheckResourceAttr("keycard_provider.test", "oauth2.scopes_supported.#", "2"),
+					resource.TestCheckTypeSetElemAttr("keycard_provider.test", "oauth2.scopes_supported.*", "openid"),
+					resource.TestCheckTypeSetElemAttr("keycard_provider.test", "oauth2.scopes_supported.*", "profile"),
+					resource.TestCheckResourceAttr("keycard_provider.test", "openid.userinfo_endpoint", identifier+"/userinfo"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "keycard_provider.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					rs := state.RootModule().Resources["keycard_provider.test"]
+					return fmt.Sprintf("zones/%s/providers/%s", rs.Primary.Attributes["zone_id"], rs.Primary.ID), nil
+				},
+				ImportStateVerifyIgnore: []string{"client_secret"},
+			},
+			// Update the jwks_uri and scopes in place
+			{
+				Config: testAccProviderResourceConfig_fullProtocols(rName, identifier, "/oauth2/v1/keys", "email"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("keycard_provider.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("keycard_provider.test", "oauth2.jwks_uri", identifier+"/oauth2/v1/keys"),
+					resource.TestCheckResourceAttr("keycard_provider.test", "oauth2.scopes_supported.#", "2"),
+					resource.TestCheckTypeSetElemAttr("keycard_provider.test", "oauth2.scopes_supported.*", "openid"),
+					resource.TestCheckTypeSetElemAttr("keycard_provider.test", "oauth2.scopes_supported.*", "email"),
+					resource.TestCheckResourceAttr("keycard_provider.test", "openid.userinfo_endpoint", identifier+"/userinfo"),
+				),
+			},
+			// Remove the jwks_uri, scopes and openid block (should unset them in place)
+			{
+				Config: testAccProviderResourceConfig_partialProtocols(rName, identifier),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("keycard_provider.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("keycard_provider.test", "oauth2.token_endpoint", identifier+"/token"),
+					resource.TestCheckResourceAttr("keycard_provider.test", "oauth2.registration_endpoint", identifier+"/register"),
+					resource.TestCheckNoResourceAttr("keycard_provider.test", "oauth2.jwks_uri"),
+					resource.TestCheckNoResourceAttr("keycard_provider.test", "oauth2.scopes_supported.#"),
+					resource.TestCheckResourceAttr("keycard_provider.test", "oauth2.code_challenge_methods_supported.#", "1"),
+					resource.TestCheckNoResourceAttr("keycard_provider.test", "openid.userinfo_endpoint"),
+				),
+			},
+			// The unset attributes stay unset
+			{
+				Config: testAccProviderResourceConfig_partialProtocols(rName, identifier),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
 func TestAccProviderResource_emptyDescriptionInvalid(t *testing.T) {
 	rName := acctest.RandomWithPrefix("tftest")
 	identifier := fmt.Sprintf("https://%s.example.com", rName)
@@ -339,3 +426,397 @@ resource "keycard_provider" "test" {
 }
 `, name, identifier, authEndpoint, tokenEndpoint)
 }
+
+func TestAccProviderResource_importKeycardVault(t *testing.T) {
+	resource.Test(t, testAccProviderResourceKeycardManagedImportTestCase(t, "keycard-vault"))
+}
+
+func TestAccProviderResource_importKeycardSts(t *testing.T) {
+	resource.Test(t, testAccProviderResourceKeycardManagedImportTestCase(t, "keycard-sts"))
+}
+
+// testAccProviderResourceKeycardManagedImportTestCase adopts the built-in provider of the given type
+// and verifies that it produces no diff against a configuration matching its current settings.
+func testAccProviderResourceKeycardManagedImportTestCase(t *testing.T, providerType string) resource.TestCase {
+	rName := acctest.RandomWithPrefix("tftest")
+
+	// Settings managed by Keycard, captured before renaming the adopted provider. Unset
+	// settings are captured too, since they must stay unset.
+	managed := map[string]*string{}
+	managedValue := func(s *terraform.State, attr string) (*string, error) {
+		rs, ok := s.RootModule().Resources["data.keycard_providers.builtin"]
+		if !ok {
+			return nil, fmt.Errorf("Not found: data.keycard_providers.builtin")
+		}
+		value, ok := rs.Primary.Attributes[attr]
+		if !ok {
+			return nil, nil
+		}
+		return &value, nil
+	}
+	captureManaged := func(attr string) resource.TestCheckFunc {
+		return func(s *terraform.State) error {
+			value, err := managedValue(s, attr)
+			managed[attr] = value
+			return err
+		}
+	}
+	unchangedManaged := func(attr string) resource.TestCheckFunc {
+		return func(s *terraform.State) error {
+			value, err := managedValue(s, attr)
+			if err != nil {
+				return err
+			}
+			if (value == nil) != (managed[attr] == nil) || (value != nil && *value != *managed[attr]) {
+				return fmt.Errorf("expected %s to be unchanged by the rename", attr)
+			}
+			return nil
+		}
+	}
+
+	return resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create a zone and look up its built-in provider
+			{
+				Config: testAccProviderResourceConfig_keycardManagedLookup(rName, providerType),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.keycard_providers.builtin", "providers.#", "1"),
+				),
+			},
+			// Adopt the built-in provider with terraform import
+			{
+				Config:             testAccProviderResourceConfig_keycardManaged(rName, providerType),
+				ResourceName:       "keycard_provider.test",
+				ImportState:        true,
+				ImportStatePersist: true,
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					rs := state.RootModule().Resources["data.keycard_providers.builtin"]
+					return fmt.Sprintf("zones/%s/providers/%s", rs.Primary.Attributes["zone_id"], rs.Primary.Attributes["providers.0.id"]), nil
+				},
+			},
+			// The adopted provider matches its configuration without changes
+			{
+				Config: testAccProviderResourceConfig_keycardManaged(rName, providerType),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("keycard_provider.test", "type", providerType),
+					resource.TestCheckResourceAttrPair(
+						"keycard_provider.test", "id",
+						"data.keycard_providers.builtin", "providers.0.id",
+					),
+					captureManaged("providers.0.client_secret_set"),
+					captureManaged("providers.0.oauth2.authorization_endpoint"),
+					captureManaged("providers.0.oauth2.token_endpoint"),
+					captureManaged("providers.0.oauth2.jwks_uri"),
+				),
+			},
+			// Rename the adopted provider (should update in place)
+			{
+				Config: testAccProviderResourceConfig_keycardManagedRenamed(rName, providerType),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("keycard_provider.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("keycard_provider.test", "name", rName+"-builtin"),
+					resource.TestCheckResourceAttr("keycard_provider.test", "description", "Adopted by Terraform"),
+				),
+			},
+			// The secret and endpoints managed by Keycard are unchanged by the rename
+			{
+				Config: testAccProviderResourceConfig_keycardManagedRenamed(rName, providerType),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.keycard_providers.builtin", "providers.0.name", rName+"-builtin"),
+					unchangedManaged("providers.0.client_secret_set"),
+					unchangedManaged("providers.0.oauth2.authorization_endpoint"),
+					unchangedManaged("providers.0.oauth2.token_endpoint"),
+					unchangedManaged("providers.0.oauth2.jwks_uri"),
+				),
+			},
+			// Destroy only removes the built-in provider from state
+		},
+	}
+}
+
+func TestAccProviderResource_keycardManagedCreateInvalid(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tftest")
+	identifier := fmt.Sprintf("https://%s.example.com", rName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderResourceConfig_withType(rName, identifier, "keycard-vault"),
+				ExpectError: regexp.MustCompile(`Unsupported Provider Type`),
+			},
+		},
+	})
+}
+
+func TestAccProviderResource_keycardManagedClientSecretInvalid(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tftest")
+	identifier := fmt.Sprintf("https://%s.example.com", rName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderResourceConfig_withTypeAndClientSecret(rName, identifier, "keycard-sts", "test-client-secret"),
+				ExpectError: regexp.MustCompile(`client_secret cannot be set for keycard-sts providers`),
+			},
+		},
+	})
+}
+
+func TestAccProviderResource_typeChangeInvalid(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tftest")
+	identifier := fmt.Sprintf("https://%s.example.com", rName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderResourceConfig_withType(rName, identifier, "external"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("keycard_provider.test", "type", "external"),
+				),
+			},
+			{
+				Config:      testAccProviderResourceConfig_withType(rName, identifier, "keycard-sts"),
+				ExpectError: regexp.MustCompile(`Provider Type Cannot Be Changed`),
+			},
+		},
+	})
+}
+
+func testAccProviderResourceConfig_withType(name, identifier, providerType string) string {
+	return fmt.Sprintf(`
+resource "keycard_zone" "test" {
+  name = %[1]q
+}
+
+resource "keycard_provider" "test" {
+  name       = %[1]q
+  zone_id    = keycard_zone.test.id
+  identifier = %[2]q
+  type       = %[3]q
+}
+`, name, identifier, providerType)
+}
+
+func testAccProviderResourceConfig_withTypeAndClientSecret(name, identifier, providerType, clientSecret string) string {
+	return fmt.Sprintf(`
+resource "keycard_zone" "test" {
+  name = %[1]q
+}
+
+resource "keycard_provider" "test" {
+  name          = %[1]q
+  zone_id       = keycard_zone.test.id
+  identifier    = %[2]q
+  type          = %[3]q
+  client_secret = %[4]q
+}
+`, name, identifier, providerType, clientSecret)
+}
+
+func testAccProviderResourceConfig_keycardManagedLookup(name, providerType string) string {
+	return fmt.Sprintf(`
+resource "keycard_zone" "test" {
+  name = %[1]q
+}
+
+data "keycard_providers" "builtin" {
+  zone_id = keycard_zone.test.id
+  type    = %[2]q
+}
+`, name, providerType)
+}
+
+func testAccProviderResourceConfig_keycardManaged(name, providerType string) string {
+	return testAccProviderResourceConfig_keycardManagedLookup(name, providerType) + `
+resource "keycard_provider" "test" {
+  zone_id     = keycard_zone.test.id
+  type        = data.keycard_providers.builtin.type
+  name        = data.keycard_providers.builtin.providers[0].name
+  identifier  = data.keycard_providers.builtin.providers[0].identifier
+  description = data.keycard_providers.builtin.providers[0].description
+  client_id   = data.keycard_providers.builtin.providers[0].client_id
+}
+`
+}
+
+func testAccProviderResourceConfig_keycardManagedRenamed(name, providerType string) string {
+	return testAccProviderResourceConfig_keycardManagedLookup(name, providerType) + fmt.Sprintf(`
+resource "keycard_provider" "test" {
+  zone_id     = keycard_zone.test.id
+  type        = data.keycard_providers.builtin.type
+  name        = "%[1]s-builtin"
+  identifier  = data.keycard_providers.builtin.providers[0].identifier
+  description = "Adopted by Terraform"
+  client_id   = data.keycard_providers.builtin.providers[0].client_id
+}
+`, name)
+}
+
+func testAccProviderResourceConfig_fullProtocols(name, identifier, jwksPath, scope string) string {
+	return fmt.Sprintf(`
+resource "keycard_zone" "test" {
+  name = %[1]q
+}
+
+resource "keycard_provider" "test" {
+  name       = %[1]q
+  zone_id    = keycard_zone.test.id
+  identifier = %[2]q
+  client_id  = "test-client-id"
+
+  oauth2 = {
+    authorization_endpoint           = "%[2]s/authorize"
+    token_endpoint                   = "%[2]s/token"
+    registration_endpoint            = "%[2]s/register"
+    jwks_uri                         = "%[2]s%[3]s"
+    code_challenge_methods_supported = ["S256"]
+    scopes_supported                 = ["openid", %[4]q]
+  }
+
+  openid = {
+    userinfo_endpoint = "%[2]s/userinfo"
+  }
+}
+`, name, identifier, jwksPath, scope)
+}
+
+func testAccProviderResourceConfig_partialProtocols(name, identifier string) string {
+	return fmt.Sprintf(`
+resource "keycard_zone" "test" {
+  name = %[1]q
+}
+
+resource "keycard_provider" "test" {
+  name       = %[1]q
+  zone_id    = keycard_zone.test.id
+  identifier = %[2]q
+  client_id  = "test-client-id"
+
+  oauth2 = {
+    authorization_endpoint           = "%[2]s/authorize"
+    token_endpoint                   = "%[2]s/token"
+    registration_endpoint            = "%[2]s/register"
+    code_challenge_methods_supported = ["S256"]
+  }
+}
+`, name, identifier)
+}
+
+func TestAccProviderResource_discovery(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tftest")
+	server := newTestDiscoveryServer(t, discoveryDocumentOpenIDConfiguration)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Discover the endpoints from the OpenID Connect configuration
+			{
+				Config: testAccProviderResourceConfig_discovery(rName, server.URL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("keycard_provider.test", "oauth2.authorization_endpoint", server.URL+"/authorize"),
+					resource.TestCheckResourceAttr("keycard_provider.test", "oauth2.token_endpoint", server.URL+"/token"),
+					resource.TestCheckResourceAttr("keycard_provider.test", "oauth2.registration_endpoint", server.URL+"/register"),
+					resource.TestCheckResourceAttr("keycard_provider.test", "oauth2.jwks_uri", server.URL+"/openid-configuration/jwks"),
+					resource.TestCheckResourceAttr("keycard_provider.test", "oauth2.scopes_supported.#", "2"),
+					resource.TestCheckResourceAttr("keycard_provider.test", "openid.userinfo_endpoint", server.URL+"/userinfo"),
+				),
+			},
+			// Explicitly set endpoints take precedence over discovered values
+			{
+				Config: testAccProviderResourceConfig_discoveryWithOverride(rName, server.URL, "https://override.example.com/token"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("keycard_provider.test", "oauth2.authorization_endpoint", server.URL+"/authorize"),
+					resource.TestCheckResourceAttr("keycard_provider.test", "oauth2.token_endpoint", "https://override.example.com/token"),
+				),
+			},
+			// Discovered endpoints match without changes
+			{
+				Config: testAccProviderResourceConfig_discoveryWithOverride(rName, server.URL, "https://override.example.com/token"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccProviderResource_discoveryFailed(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tftest")
+	server := newTestDiscoveryServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderResourceConfig_discovery(rName, server.URL),
+				ExpectError: regexp.MustCompile(`Provider Discovery Failed`),
+			},
+		},
+	})
+}
+
+func testAccProviderResourceConfig_discovery(name, identifier string) string {
+	return fmt.Sprintf(`
+resource "keycard_zone" "test" {
+  name = %[1]q
+}
+
+resource "keycard_provider" "test" {
+  name       = %[1]q
+  zone_id    = keycard_zone.test.id
+  identifier = %[2]q
+  client_id  = "test-client-id"
+
+  discovery = {}
+}
+`, name, identifier)
+}
+
+func testAccProviderResourceConfig_discoveryWithOverride(name, identifier, tokenEndpoint string) string {
+	return fmt.Sprintf(`
+resource "keycard_zone" "test" {
+  name = %[1]q
+}
+
+resource "keycard_provider" "test" {
+  name       = %[1]q
+  zone_id    = keycard_zone.test.id
+  identifier = %[2]q
+  client_id  = "test-client-id"
+
+  discovery = {
+    document = "openid-configuration"
+  }
+
+  oauth2 = {
+    token_endpoint = %[3]q
+  }
+}
+`, name, identifier, tokenEndpoint)
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
 )
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
@@ -16,6 +17,13 @@ var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServe
 	"keycard": providerserver.NewProtocol6WithError(New("test")()),
 }
 
+// testAccProtoV6ProviderFactoriesWithEcho adds the echo provider, which copies ephemeral
+// values into state so that acceptance tests can check them.
+var testAccProtoV6ProviderFactoriesWithEcho = map[string]func() (tfprotov6.ProviderServer, error){
+	"keycard": providerserver.NewProtocol6WithError(New("test")()),
+	"echo":    echoprovider.NewProviderServer(),
+}
+
 func testAccPreCheckBasic(t *testing.T) {
 	requiredEnvVars := []string{
 		"KEYCARD_CLIENT_ID",
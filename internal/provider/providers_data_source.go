@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/keycardai/terraform-provider-keycard/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ProvidersDataSource{}
+
+func NewProvidersDataSource() datasource.DataSource {
+	return &ProvidersDataSource{}
+}
+
+// ProvidersDataSource defines the data source implementation.
+type ProvidersDataSource struct {
+	client *client.ClientWithResponses
+}
+
+// ProvidersDataSourceModel describes the data source data model.
+type ProvidersDataSourceModel struct {
+	ZoneID     types.String              `tfsdk:"zone_id"`
+	Type       types.String              `tfsdk:"type"`
+	Slug       types.String              `tfsdk:"slug"`
+	Identifier types.String              `tfsdk:"identifier"`
+	Providers  []ProviderDataSourceModel `tfsdk:"providers"`
+}
+
+func (d *ProvidersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_providers"
+}
+
+func (d *ProvidersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the Keycard providers in a zone, optionally filtered by type, slug or identifier. " +
+			"Use the `type` filter to find the zone's built-in `keycard-sts` and `keycard-vault` providers.",
+
+		Attributes: map[string]schema.Attribute{
+			"zone_id": schema.StringAttribute{
+				MarkdownDescription: "The zone to list providers from.",
+				Required:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Only return providers of this type. One of `external`, `keycard-vault` or `keycard-sts`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(client.ListProvidersParamsTypeExternal),
+						string(client.ListProvidersParamsTypeKeycardVault),
+						string(client.ListProvidersParamsTypeKeycardSts),
+					),
+				},
+			},
+			"slug": schema.StringAttribute{
+				MarkdownDescription: "Only return the provider with this slug.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"identifier": schema.StringAttribute{
+				MarkdownDescription: "Only return the provider with this identifier.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"providers": schema.ListNestedAttribute{
+				MarkdownDescription: "The providers matching the filters.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: providerDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *ProvidersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ClientWithResponses)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ProvidersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProvidersDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := client.ListProvidersParams{}
+	if !data.Type.IsNull() {
+		providerType := client.ListProvidersParamsType(data.Type.ValueString())
+		params.Type = &providerType
+	}
+	if !data.Slug.IsNull() {
+		params.Slug = data.Slug.ValueStringPointer()
+	}
+	if !data.Identifier.IsNull() {
+		params.Identifier = data.Identifier.ValueStringPointer()
+	}
+
+	// Follow the page cursor until every provider has been listed
+	data.Providers = []ProviderDataSourceModel{}
+	for {
+		listResp, err := d.client.ListProvidersWithResponse(ctx, data.ZoneID.ValueString(), &params)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list providers, got error: %s", err))
+			return
+		}
+
+		if listResp.StatusCode() != 200 {
+			resp.Diagnostics.AddError(
+				"API Error",
+				fmt.Sprintf("Unable to list providers, got status %d: %s", listResp.StatusCode(), string(listResp.Body)),
+			)
+			return
+		}
+
+		if listResp.JSON200 == nil {
+			resp.Diagnostics.AddError("API Error", "Unable to list providers, no response body")
+			return
+		}
+
+		for _, provider := range listResp.JSON200.Items {
+			var providerData ProviderDataSourceModel
+			resp.Diagnostics.Append(updateProviderDataSourceModelFromAPIResponse(ctx, &provider, &providerData)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			data.Providers = append(data.Providers, providerData)
+		}
+
+		params.Cursor = nextPageCursor(listResp.JSON200.PageInfo)
+		if params.Cursor == nil {
+			break
+		}
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccProvidersDataSource_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tftest")
+	identifier := fmt.Sprintf("https://%s.example.com", rName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// List every provider in the zone
+			{
+				Config: testAccProvidersDataSourceConfig(rName, identifier, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttrPair(
+						"data.keycard_providers.test", "providers.*.id",
+						"keycard_provider.test", "id",
+					),
+					resource.TestCheckTypeSetElemNestedAttrs("data.keycard_providers.test", "providers.*", map[string]string{
+						"identifier":        identifier,
+						"type":              "external",
+						"client_secret_set": "true",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProvidersDataSource_filters(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tftest")
+	identifier := fmt.Sprintf("https://%s.example.com", rName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Filter by identifier
+			{
+				Config: testAccProvidersDataSourceConfig(rName, identifier, `identifier = keycard_provider.test.identifier`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.keycard_providers.test", "providers.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.keycard_providers.test", "providers.0.id",
+						"keycard_provider.test", "id",
+					),
+					resource.TestCheckResourceAttr("data.keycard_providers.test", "providers.0.type", "external"),
+				),
+			},
+			// Filter by type to find the zone's built-in STS provider
+			{
+				Config: testAccProvidersDataSourceConfig(rName, identifier, `type = "keycard-sts"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.keycard_providers.test", "providers.#", "1"),
+					resource.TestCheckResourceAttr("data.keycard_providers.test", "providers.0.type", "keycard-sts"),
+					resource.TestCheckResourceAttrPair(
+						"data.keycard_providers.test", "providers.0.zone_id",
+						"keycard_zone.test", "id",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testAccProvidersDataSourceConfig(name, identifier, filters string) string {
+	return fmt.Sprintf(`
+resource "keycard_zone" "test" {
+  name = %[1]q
+}
+
+resource "keycard_provider" "test" {
+  name          = %[1]q
+  zone_id       = keycard_zone.test.id
+  identifier    = %[2]q
+  client_id     = "test-client-id"
+  client_secret = "test-client-secret"
+}
+
+data "keycard_providers" "test" {
+  zone_id = keycard_zone.test.id
+  %[3]s
+
+  depends_on = [keycard_provider.test]
+}
+`, name, identifier, filters)
+}
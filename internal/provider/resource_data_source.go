@@ -33,59 +33,28 @@ func (d *ResourceDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Reads a Keycard resource. A resource is a system that exposes protected information or functionality requiring authentication.",
 
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				MarkdownDescription: "Unique identifier of the resource. Either `id` or `identifier` must be provided, but not both.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"zone_id": schema.StringAttribute{
-				MarkdownDescription: "The zone this resource belongs to.",
-				Required:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "Human-readable name for the resource.",
-				Computed:            true,
-			},
-			"description": schema.StringAttribute{
-				MarkdownDescription: "Optional description of the resource's purpose. May be empty.",
-				Computed:            true,
-			},
-			"identifier": schema.StringAttribute{
-				MarkdownDescription: "User-specified identifier for the resource, typically its URL or URN. Either `id` or `identifier` must be provided, but not both.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"credential_provider_id": schema.StringAttribute{
-				MarkdownDescription: "The provider that issues credentials for accessing this resource. May be empty.",
-				Computed:            true,
-			},
-			"application_id": schema.StringAttribute{
-				MarkdownDescription: "The application that provides this resource. May be empty.",
-				Computed:            true,
-			},
-			"metadata": schema.SingleNestedAttribute{
-				MarkdownDescription: "Metadata associated with the resource. May be empty.",
-				Computed:            true,
-				Attributes: map[string]schema.Attribute{
-					"docs_url": schema.StringAttribute{
-						MarkdownDescription: "URL to documentation relevant to this resource. May be empty.",
-						Computed:            true,
-					},
-				},
-			},
-			"oauth2": schema.SingleNestedAttribute{
-				MarkdownDescription: "OAuth2 configuration for the resource. May be empty.",
-				Computed:            true,
-				Attributes: map[string]schema.Attribute{
-					"scopes": schema.ListAttribute{
-						MarkdownDescription: "OAuth2 scopes required to access this resource. Must match scopes configured in the authorization server. May be empty.",
-						ElementType:         types.StringType,
-						Computed:            true,
-					},
-				},
-			},
-		},
+		Attributes: resourceDataSourceAttributes(),
+	}
+
+	// The resource is looked up by either ID, identifier or slug within a zone
+	resp.Schema.Attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "Unique identifier of the resource. Exactly one of `id`, `identifier` or `slug` must be provided.",
+		Optional:            true,
+		Computed:            true,
+	}
+	resp.Schema.Attributes["zone_id"] = schema.StringAttribute{
+		MarkdownDescription: "The zone this resource belongs to.",
+		Required:            true,
+	}
+	resp.Schema.Attributes["identifier"] = schema.StringAttribute{
+		MarkdownDescription: "User-specified identifier for the resource, typically its URL or URN. Exactly one of `id`, `identifier` or `slug` must be provided.",
+		Optional:            true,
+		Computed:            true,
+	}
+	resp.Schema.Attributes["slug"] = schema.StringAttribute{
+		MarkdownDescription: "URL-safe identifier of the resource, unique within the zone. Exactly one of `id`, `identifier` or `slug` must be provided.",
+		Optional:            true,
+		Computed:            true,
 	}
 }
 
@@ -114,6 +83,7 @@ func (d *ResourceDataSource) ConfigValidators(ctx context.Context) []datasource.
 		datasourcevalidator.ExactlyOneOf(
 			path.MatchRoot("id"),
 			path.MatchRoot("identifier"),
+			path.MatchRoot("slug"),
 		),
 	}
 }
@@ -161,11 +131,17 @@ func (d *ResourceDataSource) Read(ctx context.Context, req datasource.ReadReques
 
 		resource = getResp.JSON200
 	} else {
-		// Lookup by identifier
-		identifier := data.Identifier.ValueString()
-		listResp, err := d.client.ListResourcesWithResponse(ctx, data.ZoneID.ValueString(), &client.ListResourcesParams{
-			Identifier: &identifier,
-		})
+		// Lookup by identifier or slug
+		params := client.ListResourcesParams{}
+		selector := fmt.Sprintf("identifier '%s'", data.Identifier.ValueString())
+		if !data.Identifier.IsNull() {
+			params.Identifier = data.Identifier.ValueStringPointer()
+		} else {
+			params.Slug = data.Slug.ValueStringPointer()
+			selector = fmt.Sprintf("slug '%s'", data.Slug.ValueString())
+		}
+
+		listResp, err := d.client.ListResourcesWithResponse(ctx, data.ZoneID.ValueString(), &params)
 		if err != nil {
 			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list resources: %s", err))
 			return
@@ -180,7 +156,7 @@ func (d *ResourceDataSource) Read(ctx context.Context, req datasource.ReadReques
 		if resultCount == 0 {
 			resp.Diagnostics.AddError(
 				"Resource Not Found",
-				fmt.Sprintf("No resource found with identifier '%s' in zone '%s'", identifier, data.ZoneID.ValueString()),
+				fmt.Sprintf("No resource found with %s in zone '%s'", selector, data.ZoneID.ValueString()),
 			)
 			return
 		}
@@ -188,8 +164,8 @@ func (d *ResourceDataSource) Read(ctx context.Context, req datasource.ReadReques
 		if resultCount > 1 {
 			resp.Diagnostics.AddError(
 				"Multiple Resources Found",
-				fmt.Sprintf("Expected exactly 1 resource with identifier '%s' in zone '%s', but found %d. This indicates a data integrity issue.",
-					identifier, data.ZoneID.ValueString(), resultCount),
+				fmt.Sprintf("Expected exactly 1 resource with %s in zone '%s', but found %d. This indicates a data integrity issue.",
+					selector, data.ZoneID.ValueString(), resultCount),
 			)
 			return
 		}
@@ -206,3 +182,75 @@ func (d *ResourceDataSource) Read(ctx context.Context, req datasource.ReadReques
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// resourceDataSourceAttributes returns the computed attributes describing a resource in data sources.
+// Data sources that look up a single resource override the attributes used as lookup arguments.
+func resourceDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Unique identifier of the resource.",
+			Computed:            true,
+		},
+		"slug": schema.StringAttribute{
+			MarkdownDescription: "URL-safe identifier of the resource, unique within the zone.",
+			Computed:            true,
+		},
+		"organization_id": schema.StringAttribute{
+			MarkdownDescription: "The organization that owns the resource.",
+			Computed:            true,
+		},
+		"created_at": schema.StringAttribute{
+			MarkdownDescription: "The time the resource was created, in RFC 3339 format.",
+			Computed:            true,
+		},
+		"updated_at": schema.StringAttribute{
+			MarkdownDescription: "The time the resource was last updated, in RFC 3339 format.",
+			Computed:            true,
+		},
+		"zone_id": schema.StringAttribute{
+			MarkdownDescription: "The zone this resource belongs to.",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Human-readable name for the resource.",
+			Computed:            true,
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "Optional description of the resource's purpose. May be empty.",
+			Computed:            true,
+		},
+		"identifier": schema.StringAttribute{
+			MarkdownDescription: "User-specified identifier for the resource, typically its URL or URN.",
+			Computed:            true,
+		},
+		"credential_provider_id": schema.StringAttribute{
+			MarkdownDescription: "The provider that issues credentials for accessing this resource. May be empty.",
+			Computed:            true,
+		},
+		"application_id": schema.StringAttribute{
+			MarkdownDescription: "The application that provides this resource. May be empty.",
+			Computed:            true,
+		},
+		"metadata": schema.SingleNestedAttribute{
+			MarkdownDescription: "Metadata associated with the resource. May be empty.",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"docs_url": schema.StringAttribute{
+					MarkdownDescription: "URL to documentation relevant to this resource. May be empty.",
+					Computed:            true,
+				},
+			},
+		},
+		"oauth2": schema.SingleNestedAttribute{
+			MarkdownDescription: "OAuth2 configuration for the resource. May be empty.",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"scopes": schema.ListAttribute{
+					MarkdownDescription: "OAuth2 scopes required to access this resource. Must match scopes configured in the authorization server. May be empty.",
+					ElementType:         types.StringType,
+					Computed:            true,
+				},
+			},
+		},
+	}
+}
@@ -586,3 +586,68 @@ data "keycard_resource" "test" {
 }
 `, zoneName)
 }
+
+func TestAccResourceDataSource_bySlug(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tftest")
+	zoneName := acctest.RandomWithPrefix("tftest-zone")
+	providerName := acctest.RandomWithPrefix("tftest-provider")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create a resource and fetch it by slug
+			{
+				Config: testAccResourceDataSourceConfig_bySlug(zoneName, providerName, rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.keycard_resource.test", "id",
+						"keycard_resource.test", "id",
+					),
+					resource.TestCheckResourceAttrPair(
+						"data.keycard_resource.test", "slug",
+						"keycard_resource.test", "slug",
+					),
+					resource.TestCheckResourceAttrPair(
+						"data.keycard_resource.test", "organization_id",
+						"keycard_resource.test", "organization_id",
+					),
+					resource.TestCheckResourceAttrPair(
+						"data.keycard_resource.test", "created_at",
+						"keycard_resource.test", "created_at",
+					),
+					resource.TestCheckResourceAttrPair(
+						"data.keycard_resource.test", "updated_at",
+						"keycard_resource.test", "updated_at",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceDataSourceConfig_bySlug(zoneName, providerName, resourceName string) string {
+	return fmt.Sprintf(`
+resource "keycard_zone" "test" {
+  name = %[1]q
+}
+
+resource "keycard_provider" "test" {
+  name       = %[2]q
+  identifier = "https://%[2]s.example.com"
+  zone_id    = keycard_zone.test.id
+}
+
+resource "keycard_resource" "test" {
+  name                   = %[3]q
+  identifier             = "https://%[3]s.example.com"
+  zone_id                = keycard_zone.test.id
+  credential_provider_id = keycard_provider.test.id
+}
+
+data "keycard_resource" "test" {
+  zone_id = keycard_resource.test.zone_id
+  slug    = keycard_resource.test.slug
+}
+`, zoneName, providerName, resourceName)
+}
@@ -39,6 +39,10 @@ type ResourceResource struct {
 type ResourceModel struct {
 	ID                   types.String `tfsdk:"id"`
 	ZoneID               types.String `tfsdk:"zone_id"`
+	Slug                 types.String `tfsdk:"slug"`
+	OrganizationID       types.String `tfsdk:"organization_id"`
+	CreatedAt            types.String `tfsdk:"created_at"`
+	UpdatedAt            types.String `tfsdk:"updated_at"`
 	Name                 types.String `tfsdk:"name"`
 	Description          types.String `tfsdk:"description"`
 	Identifier           types.String `tfsdk:"identifier"`
@@ -86,6 +90,31 @@ func (r *ResourceResource) Schema(ctx context.Context, req resource.SchemaReques
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"slug": schema.StringAttribute{
+				MarkdownDescription: "URL-safe identifier of the resource, unique within the zone.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "The organization that owns the resource.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The time the resource was created, in RFC 3339 format.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "The time the resource was last updated, in RFC 3339 format.",
+				Computed:            true,
+			},
 			"zone_id": schema.StringAttribute{
 				MarkdownDescription: "The zone this resource belongs to. Changing this will replace the resource.",
 				Required:            true,
@@ -478,6 +507,10 @@ func updateResourceModelFromAPIResponse(ctx context.Context, apiResource *client
 	// Set basic fields
 	data.ID = types.StringValue(apiResource.Id)
 	data.ZoneID = types.StringValue(apiResource.ZoneId)
+	data.Slug = types.StringValue(apiResource.Slug)
+	data.OrganizationID = types.StringValue(apiResource.OrganizationId)
+	data.CreatedAt = TimestampValue(apiResource.CreatedAt)
+	data.UpdatedAt = TimestampValue(apiResource.UpdatedAt)
 	data.Name = types.StringValue(apiResource.Name)
 	data.Identifier = types.StringValue(apiResource.Identifier)
 	data.Description = NullableStringValue(apiResource.Description)
@@ -26,6 +26,10 @@ func TestAccResourceResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("keycard_resource.test", "name", rName),
 					resource.TestCheckResourceAttr("keycard_resource.test", "identifier", "https://"+rName+".example.com"),
 					resource.TestCheckResourceAttrSet("keycard_resource.test", "id"),
+					resource.TestCheckResourceAttrSet("keycard_resource.test", "slug"),
+					resource.TestCheckResourceAttrSet("keycard_resource.test", "organization_id"),
+					resource.TestCheckResourceAttrSet("keycard_resource.test", "created_at"),
+					resource.TestCheckResourceAttrSet("keycard_resource.test", "updated_at"),
 					resource.TestCheckResourceAttrSet("keycard_resource.test", "zone_id"),
 					resource.TestCheckResourceAttrSet("keycard_resource.test", "credential_provider_id"),
 				),
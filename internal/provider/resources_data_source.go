@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/keycardai/terraform-provider-keycard/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ResourcesDataSource{}
+
+func NewResourcesDataSource() datasource.DataSource {
+	return &ResourcesDataSource{}
+}
+
+// ResourcesDataSource defines the data source implementation.
+type ResourcesDataSource struct {
+	client *client.ClientWithResponses
+}
+
+// ResourcesDataSourceModel describes the data source data model.
+type ResourcesDataSourceModel struct {
+	ZoneID               types.String    `tfsdk:"zone_id"`
+	CredentialProviderID types.String    `tfsdk:"credential_provider_id"`
+	Slug                 types.String    `tfsdk:"slug"`
+	Identifier           types.String    `tfsdk:"identifier"`
+	Resources            []ResourceModel `tfsdk:"resources"`
+}
+
+func (d *ResourcesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resources"
+}
+
+func (d *ResourcesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the Keycard resources in a zone, optionally filtered by credential provider, slug or identifier.",
+
+		Attributes: map[string]schema.Attribute{
+			"zone_id": schema.StringAttribute{
+				MarkdownDescription: "The zone to list resources from.",
+				Required:            true,
+			},
+			"credential_provider_id": schema.StringAttribute{
+				MarkdownDescription: "Only return resources whose credentials are issued by this provider.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"slug": schema.StringAttribute{
+				MarkdownDescription: "Only return the resource with this slug.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"identifier": schema.StringAttribute{
+				MarkdownDescription: "Only return the resource with this identifier.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"resources": schema.ListNestedAttribute{
+				MarkdownDescription: "The resources matching the filters.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: resourceDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *ResourcesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ClientWithResponses)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ResourcesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ResourcesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := client.ListResourcesParams{}
+	if !data.CredentialProviderID.IsNull() {
+		params.CredentialProviderId = data.CredentialProviderID.ValueStringPointer()
+	}
+	if !data.Slug.IsNull() {
+		params.Slug = data.Slug.ValueStringPointer()
+	}
+	if !data.Identifier.IsNull() {
+		params.Identifier = data.Identifier.ValueStringPointer()
+	}
+
+	// Unlike the other list operations, listResources is not paginated and
+	// returns every matching resource in a single response.
+	listResp, err := d.client.ListResourcesWithResponse(ctx, data.ZoneID.ValueString(), &params)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list resources, got error: %s", err))
+		return
+	}
+
+	if listResp.StatusCode() != 200 {
+		resp.Diagnostics.AddError(
+			"API Error",
+			fmt.Sprintf("Unable to list resources, got status %d: %s", listResp.StatusCode(), string(listResp.Body)),
+		)
+		return
+	}
+
+	if listResp.JSON200 == nil {
+		resp.Diagnostics.AddError("API Error", "Unable to list resources, no response body")
+		return
+	}
+
+	data.Resources = []ResourceModel{}
+	for _, resource := range listResp.JSON200.Items {
+		var resourceData ResourceModel
+		resp.Diagnostics.Append(updateResourceModelFromAPIResponse(ctx, &resource, &resourceData)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.Resources = append(data.Resources, resourceData)
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
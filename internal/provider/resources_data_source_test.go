@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourcesDataSource_basic(t *testing.T) {
+	zoneName := acctest.RandomWithPrefix("tftest-zone")
+	rName := acctest.RandomWithPrefix("tftest")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// List every resource in the zone
+			{
+				Config: testAccResourcesDataSourceConfig(zoneName, rName, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.keycard_resources.test", "resources.#", "3"),
+					resource.TestCheckTypeSetElemAttrPair(
+						"data.keycard_resources.test", "resources.*.id",
+						"keycard_resource.calendar", "id",
+					),
+					resource.TestCheckTypeSetElemAttrPair(
+						"data.keycard_resources.test", "resources.*.id",
+						"keycard_resource.drive", "id",
+					),
+					resource.TestCheckTypeSetElemAttrPair(
+						"data.keycard_resources.test", "resources.*.id",
+						"keycard_resource.okta", "id",
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourcesDataSource_filters(t *testing.T) {
+	zoneName := acctest.RandomWithPrefix("tftest-zone")
+	rName := acctest.RandomWithPrefix("tftest")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Filter by credential provider
+			{
+				Config: testAccResourcesDataSourceConfig(zoneName, rName, `credential_provider_id = keycard_provider.google.id`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.keycard_resources.test", "resources.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(
+						"data.keycard_resources.test", "resources.*.id",
+						"keycard_resource.calendar", "id",
+					),
+					resource.TestCheckTypeSetElemAttrPair(
+						"data.keycard_resources.test", "resources.*.id",
+						"keycard_resource.drive", "id",
+					),
+					resource.TestCheckTypeSetElemNestedAttrs("data.keycard_resources.test", "resources.*", map[string]string{
+						"oauth2.scopes.0": "https://www.googleapis.com/auth/calendar",
+					}),
+				),
+			},
+			// Filter by identifier
+			{
+				Config: testAccResourcesDataSourceConfig(zoneName, rName, `identifier = keycard_resource.okta.identifier`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.keycard_resources.test", "resources.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.keycard_resources.test", "resources.0.id",
+						"keycard_resource.okta", "id",
+					),
+					resource.TestCheckResourceAttrPair(
+						"data.keycard_resources.test", "resources.0.credential_provider_id",
+						"keycard_provider.okta", "id",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourcesDataSourceConfig(zoneName, name, filters string) string {
+	return fmt.Sprintf(`
+resource "keycard_zone" "test" {
+  name = %[1]q
+}
+
+resource "keycard_provider" "google" {
+  name       = "%[2]s-google"
+  identifier = "https://%[2]s-google.example.com"
+  zone_id    = keycard_zone.test.id
+}
+
+resource "keycard_provider" "okta" {
+  name       = "%[2]s-okta"
+  identifier = "https://%[2]s-okta.example.com"
+  zone_id    = keycard_zone.test.id
+}
+
+resource "keycard_resource" "calendar" {
+  name                   = "%[2]s-calendar"
+  identifier             = "https://%[2]s-calendar.example.com"
+  zone_id                = keycard_zone.test.id
+  credential_provider_id = keycard_provider.google.id
+
+  oauth2 = {
+    scopes = ["https://www.googleapis.com/auth/calendar"]
+  }
+}
+
+resource "keycard_resource" "drive" {
+  name                   = "%[2]s-drive"
+  identifier             = "https://%[2]s-drive.example.com"
+  zone_id                = keycard_zone.test.id
+  credential_provider_id = keycard_provider.google.id
+}
+
+resource "keycard_resource" "okta" {
+  name                   = "%[2]s-okta"
+  identifier             = "https://%[2]s-okta-api.example.com"
+  zone_id                = keycard_zone.test.id
+  credential_provider_id = keycard_provider.okta.id
+}
+
+data "keycard_resources" "test" {
+  zone_id = keycard_zone.test.id
+  %[3]s
+
+  depends_on = [keycard_resource.calendar, keycard_resource.drive, keycard_resource.okta]
+}
+`, zoneName, name, filters)
+}
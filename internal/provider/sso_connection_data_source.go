@@ -0,0 +1,161 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/keycardai/terraform-provider-keycard/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SSOConnectionDataSource{}
+
+func NewSSOConnectionDataSource() datasource.DataSource {
+	return &SSOConnectionDataSource{}
+}
+
+// SSOConnectionDataSource defines the data source implementation.
+type SSOConnectionDataSource struct {
+	client *client.ClientWithResponses
+}
+
+// SSOConnectionDataSourceModel describes the data source data model.
+// Note: This model excludes client_secret since it's write-only and not returned by the API.
+type SSOConnectionDataSourceModel struct {
+	Enabled         types.Bool   `tfsdk:"enabled"`
+	ID              types.String `tfsdk:"id"`
+	CreatedAt       types.String `tfsdk:"created_at"`
+	UpdatedAt       types.String `tfsdk:"updated_at"`
+	Identifier      types.String `tfsdk:"identifier"`
+	ClientID        types.String `tfsdk:"client_id"`
+	ClientSecretSet types.Bool   `tfsdk:"client_secret_set"`
+}
+
+func (d *SSOConnectionDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sso_connection"
+}
+
+func (d *SSOConnectionDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the SSO connection of the Keycard organization. When SSO is not enabled for the organization, `enabled` is `false` and the remaining attributes are null.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether an SSO connection is configured for the organization.",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the SSO connection.",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The time the SSO connection was created, in RFC 3339 format.",
+				Computed:            true,
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "The time the SSO connection was last updated, in RFC 3339 format.",
+				Computed:            true,
+			},
+			"identifier": schema.StringAttribute{
+				MarkdownDescription: "SSO provider identifier (e.g., the issuer URL from your identity provider).",
+				Computed:            true,
+			},
+			"client_id": schema.StringAttribute{
+				MarkdownDescription: "OAuth 2.0 client ID from your identity provider.",
+				Computed:            true,
+			},
+			"client_secret_set": schema.BoolAttribute{
+				MarkdownDescription: "Whether a client secret is configured for the SSO connection. The secret itself is never returned.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *SSOConnectionDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ClientWithResponses)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SSOConnectionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SSOConnectionDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orgID, err := GetOrganizationID(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get organization ID: %s", err))
+		return
+	}
+
+	getResp, err := d.client.GetSSOConnectionWithResponse(ctx, orgID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read SSO connection, got error: %s", err))
+		return
+	}
+
+	if getResp.StatusCode() == 404 {
+		// SSO is not enabled for the organization
+		data.Enabled = types.BoolValue(false)
+		data.ID = types.StringNull()
+		data.CreatedAt = types.StringNull()
+		data.UpdatedAt = types.StringNull()
+		data.Identifier = types.StringNull()
+		data.ClientID = types.StringNull()
+		data.ClientSecretSet = types.BoolNull()
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	if getResp.StatusCode() != 200 {
+		resp.Diagnostics.AddError(
+			"API Error",
+			fmt.Sprintf("Unable to read SSO connection, got status %d: %s", getResp.StatusCode(), string(getResp.Body)),
+		)
+		return
+	}
+
+	if getResp.JSON200 == nil {
+		resp.Diagnostics.AddError("API Error", "Unable to read SSO connection, no response body")
+		return
+	}
+
+	ssoConn := getResp.JSON200
+	data.Enabled = types.BoolValue(true)
+	data.ID = types.StringValue(ssoConn.Id)
+	data.CreatedAt = TimestampValue(ssoConn.CreatedAt)
+	data.UpdatedAt = TimestampValue(ssoConn.UpdatedAt)
+	data.Identifier = types.StringValue(ssoConn.Identifier)
+	data.ClientID = types.StringNull()
+	if ssoConn.ClientId.IsSpecified() && !ssoConn.ClientId.IsNull() {
+		data.ClientID = types.StringValue(ssoConn.ClientId.MustGet())
+	}
+	data.ClientSecretSet = types.BoolValue(ssoConn.ClientSecretSet)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSSOConnectionDataSource_basic(t *testing.T) {
+	identifier := fmt.Sprintf("https://%s.example.com", acctest.RandomWithPrefix("tftest"))
+	clientID := acctest.RandomWithPrefix("client")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create an SSO connection and read it with the data source
+			{
+				Config: testAccSSOConnectionDataSourceConfig_basic(identifier, clientID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.keycard_sso_connection.test", "enabled", "true"),
+					resource.TestCheckResourceAttrPair(
+						"data.keycard_sso_connection.test", "id",
+						"keycard_sso_connection.test", "id",
+					),
+					resource.TestCheckResourceAttr("data.keycard_sso_connection.test", "identifier", identifier),
+					resource.TestCheckResourceAttr("data.keycard_sso_connection.test", "client_id", clientID),
+					resource.TestCheckResourceAttr("data.keycard_sso_connection.test", "client_secret_set", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSOConnectionDataSource_disabled(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read the SSO connection of an organization without SSO configured
+			{
+				Config: testAccSSOConnectionDataSourceConfig_disabled(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.keycard_sso_connection.test", "enabled", "false"),
+					resource.TestCheckNoResourceAttr("data.keycard_sso_connection.test", "id"),
+					resource.TestCheckNoResourceAttr("data.keycard_sso_connection.test", "identifier"),
+					resource.TestCheckNoResourceAttr("data.keycard_sso_connection.test", "client_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSSOConnectionDataSourceConfig_basic(identifier, clientID string) string {
+	return fmt.Sprintf(`
+resource "keycard_sso_connection" "test" {
+  identifier = %[1]q
+  client_id  = %[2]q
+}
+
+data "keycard_sso_connection" "test" {
+  depends_on = [keycard_sso_connection.test]
+}
+`, identifier, clientID)
+}
+
+func testAccSSOConnectionDataSourceConfig_disabled() string {
+	return `
+data "keycard_sso_connection" "test" {}
+`
+}
@@ -33,6 +33,8 @@ type SSOConnectionResource struct {
 // SSOConnectionResourceModel describes the resource data model.
 type SSOConnectionResourceModel struct {
 	ID           types.String `tfsdk:"id"`
+	CreatedAt    types.String `tfsdk:"created_at"`
+	UpdatedAt    types.String `tfsdk:"updated_at"`
 	Identifier   types.String `tfsdk:"identifier"`
 	ClientID     types.String `tfsdk:"client_id"`
 	ClientSecret types.String `tfsdk:"client_secret"`
@@ -54,6 +56,17 @@ func (r *SSOConnectionResource) Schema(ctx context.Context, req resource.SchemaR
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The time the SSO connection was created, in RFC 3339 format.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "The time the SSO connection was last updated, in RFC 3339 format.",
+				Computed:            true,
+			},
 			"identifier": schema.StringAttribute{
 				MarkdownDescription: "SSO provider identifier (e.g., the issuer URL from your identity provider).",
 				Required:            true,
@@ -144,6 +157,8 @@ func (r *SSOConnectionResource) Create(ctx context.Context, req resource.CreateR
 	ssoConn := createResp.JSON201
 	data.ID = types.StringValue(ssoConn.Id)
 	data.Identifier = types.StringValue(ssoConn.Identifier)
+	data.CreatedAt = TimestampValue(ssoConn.CreatedAt)
+	data.UpdatedAt = TimestampValue(ssoConn.UpdatedAt)
 	if ssoConn.ClientId.IsSpecified() && !ssoConn.ClientId.IsNull() {
 		data.ClientID = types.StringValue(ssoConn.ClientId.MustGet())
 	}
@@ -193,6 +208,8 @@ func (r *SSOConnectionResource) Read(ctx context.Context, req resource.ReadReque
 	ssoConn := getResp.JSON200
 	data.ID = types.StringValue(ssoConn.Id)
 	data.Identifier = types.StringValue(ssoConn.Identifier)
+	data.CreatedAt = TimestampValue(ssoConn.CreatedAt)
+	data.UpdatedAt = TimestampValue(ssoConn.UpdatedAt)
 	if ssoConn.ClientId.IsSpecified() && !ssoConn.ClientId.IsNull() {
 		data.ClientID = types.StringValue(ssoConn.ClientId.MustGet())
 	}
@@ -254,6 +271,8 @@ func (r *SSOConnectionResource) Update(ctx context.Context, req resource.UpdateR
 	ssoConn := updateResp.JSON200
 	data.ID = types.StringValue(ssoConn.Id)
 	data.Identifier = types.StringValue(ssoConn.Identifier)
+	data.CreatedAt = TimestampValue(ssoConn.CreatedAt)
+	data.UpdatedAt = TimestampValue(ssoConn.UpdatedAt)
 	if ssoConn.ClientId.IsSpecified() && !ssoConn.ClientId.IsNull() {
 		data.ClientID = types.StringValue(ssoConn.ClientId.MustGet())
 	}
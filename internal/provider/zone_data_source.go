@@ -4,13 +4,16 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/keycardai/terraform-provider-keycard/internal/client"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ datasource.DataSource = &ZoneDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &ZoneDataSource{}
 
 func NewZoneDataSource() datasource.DataSource {
 	return &ZoneDataSource{}
@@ -27,60 +30,21 @@ func (d *ZoneDataSource) Metadata(ctx context.Context, req datasource.MetadataRe
 
 func (d *ZoneDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Fetches information about an existing Keycard zone by ID.",
+		MarkdownDescription: "Fetches information about an existing Keycard zone by ID or slug.",
 
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				MarkdownDescription: "Unique identifier of the zone.",
-				Required:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "Human-readable name for the zone.",
-				Computed:            true,
-			},
-			"description": schema.StringAttribute{
-				MarkdownDescription: "Optional description of the zone's purpose. May be empty.",
-				Computed:            true,
-			},
-			"oauth2": schema.SingleNestedAttribute{
-				MarkdownDescription: "OAuth2 configuration for the zone.",
-				Computed:            true,
-				Attributes: map[string]schema.Attribute{
-					"pkce_required": schema.BoolAttribute{
-						MarkdownDescription: "Whether PKCE (Proof Key for Code Exchange) is required for authorization code flows.",
-						Computed:            true,
-					},
-					"dcr_enabled": schema.BoolAttribute{
-						MarkdownDescription: "Whether Dynamic Client Registration (DCR) is enabled.",
-						Computed:            true,
-					},
-					"issuer_uri": schema.StringAttribute{
-						MarkdownDescription: "OAuth 2.0 issuer URI for this zone.",
-						Computed:            true,
-					},
-					"redirect_uri": schema.StringAttribute{
-						MarkdownDescription: "OAuth 2.0 redirect URI for this zone.",
-						Computed:            true,
-					},
-				},
-			},
-			"encryption_key": schema.SingleNestedAttribute{
-				MarkdownDescription: "Encryption key configuration for the zone.",
-				Computed:            true,
-				Attributes: map[string]schema.Attribute{
-					"aws": schema.SingleNestedAttribute{
-						MarkdownDescription: "AWS KMS configuration for encryption.",
-						Computed:            true,
-						Attributes: map[string]schema.Attribute{
-							"arn": schema.StringAttribute{
-								MarkdownDescription: "ARN of the AWS KMS key used for encryption.",
-								Computed:            true,
-							},
-						},
-					},
-				},
-			},
-		},
+		Attributes: zoneDataSourceAttributes(),
+	}
+
+	// The zone is looked up by either ID or slug
+	resp.Schema.Attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "Unique identifier of the zone. Either `id` or `slug` must be provided, but not both.",
+		Optional:            true,
+		Computed:            true,
+	}
+	resp.Schema.Attributes["slug"] = schema.StringAttribute{
+		MarkdownDescription: "URL-safe identifier of the zone, unique within the organization. Either `id` or `slug` must be provided, but not both.",
+		Optional:            true,
+		Computed:            true,
 	}
 }
 
@@ -104,6 +68,15 @@ func (d *ZoneDataSource) Configure(ctx context.Context, req datasource.Configure
 	d.client = client
 }
 
+func (d *ZoneDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("slug"),
+		),
+	}
+}
+
 func (d *ZoneDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data ZoneResourceModel
 
@@ -114,36 +87,84 @@ func (d *ZoneDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		return
 	}
 
-	// Get the zone
-	getResp, err := d.client.GetZoneWithResponse(ctx, data.ID.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read zone, got error: %s", err))
-		return
-	}
+	var zone *client.Zone
 
-	if getResp.StatusCode() == 404 {
-		resp.Diagnostics.AddError(
-			"Zone Not Found",
-			fmt.Sprintf("Unable to find zone with ID %s. The zone may have been deleted or does not exist.", data.ID.ValueString()),
-		)
-		return
-	}
+	if !data.ID.IsNull() {
+		// Lookup by ID
+		getResp, err := d.client.GetZoneWithResponse(ctx, data.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read zone, got error: %s", err))
+			return
+		}
 
-	if getResp.StatusCode() != 200 {
-		resp.Diagnostics.AddError(
-			"API Error",
-			fmt.Sprintf("Unable to read zone, got status %d: %s", getResp.StatusCode(), string(getResp.Body)),
-		)
-		return
-	}
+		if getResp.StatusCode() == 404 {
+			resp.Diagnostics.AddError(
+				"Zone Not Found",
+				fmt.Sprintf("Unable to find zone with ID %s. The zone may have been deleted or does not exist.", data.ID.ValueString()),
+			)
+			return
+		}
 
-	if getResp.JSON200 == nil {
-		resp.Diagnostics.AddError("API Error", "Unable to read zone, no response body")
-		return
+		if getResp.StatusCode() != 200 {
+			resp.Diagnostics.AddError(
+				"API Error",
+				fmt.Sprintf("Unable to read zone, got status %d: %s", getResp.StatusCode(), string(getResp.Body)),
+			)
+			return
+		}
+
+		if getResp.JSON200 == nil {
+			resp.Diagnostics.AddError("API Error", "Unable to read zone, no response body")
+			return
+		}
+
+		zone = getResp.JSON200
+	} else {
+		// Lookup by slug
+		slug := data.Slug.ValueString()
+		listResp, err := d.client.ListZonesWithResponse(ctx, &client.ListZonesParams{
+			Slug: &slug,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list zones, got error: %s", err))
+			return
+		}
+
+		if listResp.StatusCode() != 200 {
+			resp.Diagnostics.AddError(
+				"API Error",
+				fmt.Sprintf("Unable to list zones, got status %d: %s", listResp.StatusCode(), string(listResp.Body)),
+			)
+			return
+		}
+
+		if listResp.JSON200 == nil {
+			resp.Diagnostics.AddError("API Error", "Unable to list zones, no response body")
+			return
+		}
+
+		resultCount := len(listResp.JSON200.Items)
+		if resultCount == 0 {
+			resp.Diagnostics.AddError(
+				"Zone Not Found",
+				fmt.Sprintf("No zone found with slug '%s'", slug),
+			)
+			return
+		}
+
+		if resultCount > 1 {
+			resp.Diagnostics.AddError(
+				"Multiple Zones Found",
+				fmt.Sprintf("Expected exactly 1 zone with slug '%s', but found %d. This indicates a data integrity issue.", slug, resultCount),
+			)
+			return
+		}
+
+		zone = &listResp.JSON200.Items[0]
 	}
 
 	// Update the model with the response data
-	resp.Diagnostics.Append(updateZoneModelFromAPIResponse(ctx, getResp.JSON200, &data)...)
+	resp.Diagnostics.Append(updateZoneModelFromAPIResponse(ctx, zone, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -151,3 +172,122 @@ func (d *ZoneDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// zoneDataSourceAttributes returns the computed attributes describing a zone in data sources.
+// Data sources that look up a single zone override the attributes used as lookup arguments.
+func zoneDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Unique identifier of the zone.",
+			Computed:            true,
+		},
+		"slug": schema.StringAttribute{
+			MarkdownDescription: "URL-safe identifier of the zone, unique within the organization.",
+			Computed:            true,
+		},
+		"organization_id": schema.StringAttribute{
+			MarkdownDescription: "The organization that owns the zone.",
+			Computed:            true,
+		},
+		"created_at": schema.StringAttribute{
+			MarkdownDescription: "The time the zone was created, in RFC 3339 format.",
+			Computed:            true,
+		},
+		"updated_at": schema.StringAttribute{
+			MarkdownDescription: "The time the zone was last updated, in RFC 3339 format.",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Human-readable name for the zone.",
+			Computed:            true,
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "Optional description of the zone's purpose. May be empty.",
+			Computed:            true,
+		},
+		"cname": schema.StringAttribute{
+			MarkdownDescription: "Custom domain name for the zone. May be empty.",
+			Computed:            true,
+		},
+		"dns_record_name": schema.StringAttribute{
+			MarkdownDescription: "Name of the CNAME record for the custom domain. May be empty.",
+			Computed:            true,
+		},
+		"dns_record_target": schema.StringAttribute{
+			MarkdownDescription: "Keycard hostname of the zone that the custom domain's CNAME record points to.",
+			Computed:            true,
+		},
+		"oauth2": schema.SingleNestedAttribute{
+			MarkdownDescription: "OAuth2 configuration for the zone.",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"pkce_required": schema.BoolAttribute{
+					MarkdownDescription: "Whether PKCE (Proof Key for Code Exchange) is required for authorization code flows.",
+					Computed:            true,
+				},
+				"dcr_enabled": schema.BoolAttribute{
+					MarkdownDescription: "Whether Dynamic Client Registration (DCR) is enabled.",
+					Computed:            true,
+				},
+				"issuer_uri": schema.StringAttribute{
+					MarkdownDescription: "OAuth 2.0 issuer URI for this zone.",
+					Computed:            true,
+				},
+				"redirect_uri": schema.StringAttribute{
+					MarkdownDescription: "OAuth 2.0 redirect URI for this zone.",
+					Computed:            true,
+				},
+				"authorization_endpoint": schema.StringAttribute{
+					MarkdownDescription: "OAuth 2.0 authorization endpoint URL for this zone.",
+					Computed:            true,
+				},
+				"token_endpoint": schema.StringAttribute{
+					MarkdownDescription: "OAuth 2.0 token endpoint URL for this zone.",
+					Computed:            true,
+				},
+				"registration_endpoint": schema.StringAttribute{
+					MarkdownDescription: "OAuth 2.0 Dynamic Client Registration endpoint URL for this zone.",
+					Computed:            true,
+				},
+				"jwks_uri": schema.StringAttribute{
+					MarkdownDescription: "JSON Web Key Set URL publishing the keys this zone signs tokens with.",
+					Computed:            true,
+				},
+				"authorization_server_metadata": schema.StringAttribute{
+					MarkdownDescription: "OAuth 2.0 Authorization Server Metadata URL (`.well-known/oauth-authorization-server`) for this zone.",
+					Computed:            true,
+				},
+			},
+		},
+		"openid": schema.SingleNestedAttribute{
+			MarkdownDescription: "OpenID Connect configuration for the zone.",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"provider_configuration": schema.StringAttribute{
+					MarkdownDescription: "OpenID Connect Provider Configuration URL (`.well-known/openid-configuration`) for this zone.",
+					Computed:            true,
+				},
+				"userinfo_endpoint": schema.StringAttribute{
+					MarkdownDescription: "OpenID Connect UserInfo endpoint URL for this zone.",
+					Computed:            true,
+				},
+			},
+		},
+		"encryption_key": schema.SingleNestedAttribute{
+			MarkdownDescription: "Encryption key configuration for the zone.",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"aws": schema.SingleNestedAttribute{
+					MarkdownDescription: "AWS KMS configuration for encryption.",
+					Computed:            true,
+					Attributes: map[string]schema.Attribute{
+						"arn": schema.StringAttribute{
+							MarkdownDescription: "ARN of the AWS KMS key used for encryption.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
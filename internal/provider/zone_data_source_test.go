@@ -104,6 +104,35 @@ func TestAccZoneDataSource_oauth2(t *testing.T) {
 						"data.keycard_zone.test", "oauth2.redirect_uri",
 						"keycard_zone.test", "oauth2.redirect_uri",
 					),
+					resource.TestCheckResourceAttrPair(
+						"data.keycard_zone.test", "oauth2.authorization_endpoint",
+						"keycard_zone.test", "oauth2.authorization_endpoint",
+					),
+					resource.TestCheckResourceAttrPair(
+						"data.keycard_zone.test", "oauth2.token_endpoint",
+						"keycard_zone.test", "oauth2.token_endpoint",
+					),
+					resource.TestCheckResourceAttrPair(
+						"data.keycard_zone.test", "oauth2.registration_endpoint",
+						"keycard_zone.test", "oauth2.registration_endpoint",
+					),
+					resource.TestCheckResourceAttrPair(
+						"data.keycard_zone.test", "oauth2.jwks_uri",
+						"keycard_zone.test", "oauth2.jwks_uri",
+					),
+					resource.TestCheckResourceAttrPair(
+						"data.keycard_zone.test", "oauth2.authorization_server_metadata",
+						"keycard_zone.test", "oauth2.authorization_server_metadata",
+					),
+					// Verify OpenID protocol URIs match between resource and data source
+					resource.TestCheckResourceAttrPair(
+						"data.keycard_zone.test", "openid.provider_configuration",
+						"keycard_zone.test", "openid.provider_configuration",
+					),
+					resource.TestCheckResourceAttrPair(
+						"data.keycard_zone.test", "openid.userinfo_endpoint",
+						"keycard_zone.test", "openid.userinfo_endpoint",
+					),
 				),
 			},
 		},
@@ -241,3 +270,111 @@ data "keycard_zone" "test" {
 }
 `, name, kmsArn)
 }
+
+func TestAccZoneDataSource_withCname(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tftest")
+	cname := fmt.Sprintf("%s.example.com", rName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create a zone with a custom domain and fetch it
+			{
+				Config: testAccZoneDataSourceConfig_withCname(rName, cname),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.keycard_zone.test", "cname", cname),
+					resource.TestCheckResourceAttrPair(
+						"data.keycard_zone.test", "dns_record_name",
+						"keycard_zone.test", "dns_record_name",
+					),
+					resource.TestCheckResourceAttrPair(
+						"data.keycard_zone.test", "dns_record_target",
+						"keycard_zone.test", "dns_record_target",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testAccZoneDataSourceConfig_withCname(name, cname string) string {
+	return fmt.Sprintf(`
+resource "keycard_zone" "test" {
+  name  = %[1]q
+  cname = %[2]q
+}
+
+data "keycard_zone" "test" {
+  id = keycard_zone.test.id
+}
+`, name, cname)
+}
+
+func TestAccZoneDataSource_bySlug(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tftest")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create a zone and fetch it by slug
+			{
+				Config: testAccZoneDataSourceConfig_bySlug(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.keycard_zone.test", "id",
+						"keycard_zone.test", "id",
+					),
+					resource.TestCheckResourceAttrPair(
+						"data.keycard_zone.test", "slug",
+						"keycard_zone.test", "slug",
+					),
+					resource.TestCheckResourceAttrPair(
+						"data.keycard_zone.test", "organization_id",
+						"keycard_zone.test", "organization_id",
+					),
+					resource.TestCheckResourceAttrPair(
+						"data.keycard_zone.test", "created_at",
+						"keycard_zone.test", "created_at",
+					),
+					resource.TestCheckResourceAttrPair(
+						"data.keycard_zone.test", "updated_at",
+						"keycard_zone.test", "updated_at",
+					),
+					resource.TestCheckResourceAttr("data.keycard_zone.test", "name", rName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccZoneDataSource_bySlugNotFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Attempt to fetch a zone by a slug that doesn't exist
+			{
+				Config: `
+data "keycard_zone" "test" {
+  slug = "non-existent-zone-slug-12345"
+}
+`,
+				ExpectError: regexp.MustCompile("Zone Not Found"),
+			},
+		},
+	})
+}
+
+func testAccZoneDataSourceConfig_bySlug(name string) string {
+	return fmt.Sprintf(`
+resource "keycard_zone" "test" {
+  name = %[1]q
+}
+
+data "keycard_zone" "test" {
+  slug = keycard_zone.test.slug
+}
+`, name)
+}
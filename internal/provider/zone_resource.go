@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/url"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -26,6 +27,7 @@ import (
 var (
 	_ resource.Resource                = &ZoneResource{}
 	_ resource.ResourceWithImportState = &ZoneResource{}
+	_ resource.ResourceWithModifyPlan  = &ZoneResource{}
 )
 
 func NewZoneResource() resource.Resource {
@@ -39,27 +41,58 @@ type ZoneResource struct {
 
 // ZoneResourceModel describes the resource data model.
 type ZoneResourceModel struct {
-	ID            types.String `tfsdk:"id"`
-	Name          types.String `tfsdk:"name"`
-	Description   types.String `tfsdk:"description"`
-	OAuth2        types.Object `tfsdk:"oauth2"`
-	EncryptionKey types.Object `tfsdk:"encryption_key"`
+	ID              types.String `tfsdk:"id"`
+	Slug            types.String `tfsdk:"slug"`
+	OrganizationID  types.String `tfsdk:"organization_id"`
+	CreatedAt       types.String `tfsdk:"created_at"`
+	UpdatedAt       types.String `tfsdk:"updated_at"`
+	Name            types.String `tfsdk:"name"`
+	Description     types.String `tfsdk:"description"`
+	Cname           types.String `tfsdk:"cname"`
+	DNSRecordName   types.String `tfsdk:"dns_record_name"`
+	DNSRecordTarget types.String `tfsdk:"dns_record_target"`
+	OAuth2          types.Object `tfsdk:"oauth2"`
+	OpenID          types.Object `tfsdk:"openid"`
+	EncryptionKey   types.Object `tfsdk:"encryption_key"`
 }
 
 // OAuth2Model describes the nested oauth2 block data model.
 type OAuth2Model struct {
-	PkceRequired types.Bool   `tfsdk:"pkce_required"`
-	DcrEnabled   types.Bool   `tfsdk:"dcr_enabled"`
-	IssuerUri    types.String `tfsdk:"issuer_uri"`
-	RedirectUri  types.String `tfsdk:"redirect_uri"`
+	PkceRequired                types.Bool   `tfsdk:"pkce_required"`
+	DcrEnabled                  types.Bool   `tfsdk:"dcr_enabled"`
+	IssuerUri                   types.String `tfsdk:"issuer_uri"`
+	RedirectUri                 types.String `tfsdk:"redirect_uri"`
+	AuthorizationEndpoint       types.String `tfsdk:"authorization_endpoint"`
+	TokenEndpoint               types.String `tfsdk:"token_endpoint"`
+	RegistrationEndpoint        types.String `tfsdk:"registration_endpoint"`
+	JwksUri                     types.String `tfsdk:"jwks_uri"`
+	AuthorizationServerMetadata types.String `tfsdk:"authorization_server_metadata"`
 }
 
 func (m OAuth2Model) AttributeTypes() map[string]attr.Type {
 	return map[string]attr.Type{
-		"pkce_required": types.BoolType,
-		"dcr_enabled":   types.BoolType,
-		"issuer_uri":    types.StringType,
-		"redirect_uri":  types.StringType,
+		"pkce_required":                 types.BoolType,
+		"dcr_enabled":                   types.BoolType,
+		"issuer_uri":                    types.StringType,
+		"redirect_uri":                  types.StringType,
+		"authorization_endpoint":        types.StringType,
+		"token_endpoint":                types.StringType,
+		"registration_endpoint":         types.StringType,
+		"jwks_uri":                      types.StringType,
+		"authorization_server_metadata": types.StringType,
+	}
+}
+
+// ZoneOpenIDModel describes the nested openid block data model.
+type ZoneOpenIDModel struct {
+	ProviderConfiguration types.String `tfsdk:"provider_configuration"`
+	UserinfoEndpoint      types.String `tfsdk:"userinfo_endpoint"`
+}
+
+func (m ZoneOpenIDModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"provider_configuration": types.StringType,
+		"userinfo_endpoint":      types.StringType,
 	}
 }
 
@@ -101,6 +134,31 @@ func (r *ZoneResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"slug": schema.StringAttribute{
+				MarkdownDescription: "URL-safe identifier of the zone, unique within the organization.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "The organization that owns the zone.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The time the zone was created, in RFC 3339 format.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "The time the zone was last updated, in RFC 3339 format.",
+				Computed:            true,
+			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Human-readable name for the zone.",
 				Required:            true,
@@ -112,6 +170,24 @@ func (r *ZoneResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 					stringvalidator.LengthAtLeast(1),
 				},
 			},
+			"cname": schema.StringAttribute{
+				MarkdownDescription: "Custom domain name for the zone (e.g., `auth.example.com`). A CNAME record named `dns_record_name` pointing to `dns_record_target` must be created in your DNS provider. Removing this attribute removes the custom domain.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"dns_record_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the CNAME record to create for the custom domain. Null when `cname` is not set.",
+				Computed:            true,
+			},
+			"dns_record_target": schema.StringAttribute{
+				MarkdownDescription: "Keycard hostname of the zone that the custom domain's CNAME record must point to.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"oauth2": schema.SingleNestedAttribute{
 				MarkdownDescription: "OAuth2 configuration for the zone.",
 				Optional:            true,
@@ -149,6 +225,58 @@ func (r *ZoneResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 							stringplanmodifier.UseStateForUnknown(),
 						},
 					},
+					"authorization_endpoint": schema.StringAttribute{
+						MarkdownDescription: "OAuth 2.0 authorization endpoint URL for this zone.",
+						Computed:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"token_endpoint": schema.StringAttribute{
+						MarkdownDescription: "OAuth 2.0 token endpoint URL for this zone.",
+						Computed:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"registration_endpoint": schema.StringAttribute{
+						MarkdownDescription: "OAuth 2.0 Dynamic Client Registration endpoint URL for this zone.",
+						Computed:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"jwks_uri": schema.StringAttribute{
+						MarkdownDescription: "JSON Web Key Set URL publishing the keys this zone signs tokens with.",
+						Computed:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"authorization_server_metadata": schema.StringAttribute{
+						MarkdownDescription: "OAuth 2.0 Authorization Server Metadata URL (`.well-known/oauth-authorization-server`) for this zone.",
+						Computed:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"openid": schema.SingleNestedAttribute{
+				MarkdownDescription: "OpenID Connect configuration for the zone.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"provider_configuration": schema.StringAttribute{
+						MarkdownDescription: "OpenID Connect Provider Configuration URL (`.well-known/openid-configuration`) for this zone.",
+						Computed:            true,
+					},
+					"userinfo_endpoint": schema.StringAttribute{
+						MarkdownDescription: "OpenID Connect UserInfo endpoint URL for this zone.",
+						Computed:            true,
+					},
 				},
 				PlanModifiers: []planmodifier.Object{
 					objectplanmodifier.UseStateForUnknown(),
@@ -204,20 +332,48 @@ func updateZoneModelFromAPIResponse(ctx context.Context, zone *client.Zone, data
 	var diags diag.Diagnostics
 
 	data.ID = types.StringValue(zone.Id)
+	data.Slug = types.StringValue(zone.Slug)
+	data.OrganizationID = types.StringValue(zone.OrganizationId)
+	data.CreatedAt = TimestampValue(zone.CreatedAt)
+	data.UpdatedAt = TimestampValue(zone.UpdatedAt)
 	data.Name = types.StringValue(zone.Name)
 	data.Description = NullableStringValue(zone.Description)
+	data.Cname = types.StringPointerValue(zone.Cname)
+	data.DNSRecordName = types.StringPointerValue(zone.Cname)
+
+	// Keep the previously known target when the zone no longer reports its Keycard hostname
+	if target := zoneDefaultHostname(zone); target != "" {
+		data.DNSRecordTarget = types.StringValue(target)
+	} else if data.DNSRecordTarget.IsUnknown() {
+		data.DNSRecordTarget = types.StringNull()
+	}
 
 	oauth2Data := OAuth2Model{
 		PkceRequired: types.BoolValue(zone.Protocols.Oauth2.PkceRequired),
 		DcrEnabled:   types.BoolValue(zone.Protocols.Oauth2.DcrEnabled),
 		IssuerUri:    types.StringValue(zone.Protocols.Oauth2.Issuer),
 		RedirectUri:  types.StringValue(zone.Protocols.Oauth2.RedirectUri),
+
+		AuthorizationEndpoint:       types.StringValue(zone.Protocols.Oauth2.AuthorizationEndpoint),
+		TokenEndpoint:               types.StringValue(zone.Protocols.Oauth2.TokenEndpoint),
+		RegistrationEndpoint:        types.StringValue(zone.Protocols.Oauth2.RegistrationEndpoint),
+		JwksUri:                     types.StringValue(zone.Protocols.Oauth2.JwksUri),
+		AuthorizationServerMetadata: types.StringValue(zone.Protocols.Oauth2.AuthorizationServerMetadata),
 	}
 
 	oauth2Obj, objDiags := types.ObjectValueFrom(ctx, oauth2Data.AttributeTypes(), oauth2Data)
 	diags.Append(objDiags...)
 	data.OAuth2 = oauth2Obj
 
+	openIDData := ZoneOpenIDModel{
+		ProviderConfiguration: types.StringValue(zone.Protocols.Openid.ProviderConfiguration),
+		UserinfoEndpoint:      types.StringValue(zone.Protocols.Openid.UserinfoEndpoint),
+	}
+
+	openIDObj, objDiags := types.ObjectValueFrom(ctx, openIDData.AttributeTypes(), openIDData)
+	diags.Append(objDiags...)
+	data.OpenID = openIDObj
+
 	// Handle encryption_key if present in API response
 	if zone.EncryptionKey != nil {
 		// Transform from API format {type: "aws", arn: "..."} to Terraform format {aws: {arn: "..."}}
@@ -245,6 +401,84 @@ func updateZoneModelFromAPIResponse(ctx context.Context, zone *client.Zone, data
 	return diags
 }
 
+// zoneDefaultHostname returns the Keycard hostname of the zone, which is the target of the
+// CNAME record for its custom domain. The hostname is taken from the zone's protocol endpoints,
+// skipping any that are already served from the custom domain. It returns an empty string
+// when no such endpoint is found.
+func zoneDefaultHostname(zone *client.Zone) string {
+	endpoints := []string{
+		zone.Protocols.Oauth2.Issuer,
+		zone.Protocols.Oauth2.AuthorizationEndpoint,
+		zone.Protocols.Oauth2.TokenEndpoint,
+		zone.Protocols.Oauth2.JwksUri,
+		zone.Protocols.Oauth2.RedirectUri,
+	}
+
+	for _, endpoint := range endpoints {
+		u, err := url.Parse(endpoint)
+		if err != nil || u.Hostname() == "" {
+			continue
+		}
+
+		if zone.Cname != nil && u.Hostname() == *zone.Cname {
+			continue
+		}
+
+		return u.Hostname()
+	}
+
+	return ""
+}
+
+func (r *ZoneResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when the zone is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	// The CNAME record is always named after the custom domain itself
+	var cname types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("cname"), &cname)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("dns_record_name"), cname)...)
+
+	// Nothing else to plan when the zone is being created
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var priorCname types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("cname"), &priorCname)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var oauth2 types.Object
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("oauth2"), &oauth2)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The protocol endpoints may move to the custom domain, so they are only known after apply
+	if !cname.Equal(priorCname) && !oauth2.IsNull() && !oauth2.IsUnknown() {
+		for _, attribute := range []string{
+			"issuer_uri",
+			"redirect_uri",
+			"authorization_endpoint",
+			"token_endpoint",
+			"registration_endpoint",
+			"jwks_uri",
+			"authorization_server_metadata",
+		} {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("oauth2").AtName(attribute), types.StringUnknown())...)
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("openid"), types.ObjectUnknown(ZoneOpenIDModel{}.AttributeTypes()))...)
+	}
+}
+
 func (r *ZoneResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data ZoneResourceModel
 
@@ -266,6 +500,11 @@ func (r *ZoneResource) Create(ctx context.Context, req resource.CreateRequest, r
 		createReq.Description = nullable.NewNullableWithValue(desc)
 	}
 
+	// Set cname if provided
+	if !data.Cname.IsNull() && !data.Cname.IsUnknown() {
+		createReq.Cname = data.Cname.ValueStringPointer()
+	}
+
 	// Set OAuth2 configuration if provided
 	if !data.OAuth2.IsNull() && !data.OAuth2.IsUnknown() {
 		var oauth2Data OAuth2Model
@@ -414,6 +653,11 @@ func (r *ZoneResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		updateReq.Description = StringValueNullable(data.Description)
 	}
 
+	// Set cname (including null to remove the custom domain)
+	if !data.Cname.IsUnknown() {
+		updateReq.Cname = StringValueNullable(data.Cname)
+	}
+
 	// Set OAuth2 configuration if provided
 	if !data.OAuth2.IsUnknown() {
 		var oauth2Data OAuth2Model
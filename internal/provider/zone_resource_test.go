@@ -53,6 +53,10 @@ func TestAccZoneResource_basic(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("keycard_zone.test", "name", rName),
 					resource.TestCheckResourceAttrSet("keycard_zone.test", "id"),
+					resource.TestCheckResourceAttrSet("keycard_zone.test", "slug"),
+					resource.TestCheckResourceAttrSet("keycard_zone.test", "organization_id"),
+					resource.TestCheckResourceAttrSet("keycard_zone.test", "created_at"),
+					resource.TestCheckResourceAttrSet("keycard_zone.test", "updated_at"),
 					testAccCheckZoneIDSaved(&zoneID),
 					// Verify OAuth2 values are populated by the API
 					resource.TestCheckResourceAttrSet("keycard_zone.test", "oauth2.pkce_required"),
@@ -60,6 +64,14 @@ func TestAccZoneResource_basic(t *testing.T) {
 					// Verify OAuth2 protocol URIs are populated by the API
 					resource.TestCheckResourceAttrSet("keycard_zone.test", "oauth2.issuer_uri"),
 					resource.TestCheckResourceAttrSet("keycard_zone.test", "oauth2.redirect_uri"),
+					resource.TestCheckResourceAttrSet("keycard_zone.test", "oauth2.authorization_endpoint"),
+					resource.TestCheckResourceAttrSet("keycard_zone.test", "oauth2.token_endpoint"),
+					resource.TestCheckResourceAttrSet("keycard_zone.test", "oauth2.registration_endpoint"),
+					resource.TestCheckResourceAttrSet("keycard_zone.test", "oauth2.jwks_uri"),
+					resource.TestCheckResourceAttrSet("keycard_zone.test", "oauth2.authorization_server_metadata"),
+					// Verify OpenID protocol URIs are populated by the API
+					resource.TestCheckResourceAttrSet("keycard_zone.test", "openid.provider_configuration"),
+					resource.TestCheckResourceAttrSet("keycard_zone.test", "openid.userinfo_endpoint"),
 				),
 			},
 			// ImportState testing
@@ -413,3 +425,62 @@ resource "keycard_zone" "test" {
 }
 `, name, kmsArn)
 }
+
+func TestAccZoneResource_cname(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tftest")
+	cname1 := fmt.Sprintf("%s.example.com", rName)
+	cname2 := fmt.Sprintf("auth.%s.example.com", rName)
+	var zoneID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with a custom domain
+			{
+				Config: testAccZoneResourceConfig_withCname(rName, cname1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("keycard_zone.test", "cname", cname1),
+					resource.TestCheckResourceAttr("keycard_zone.test", "dns_record_name", cname1),
+					resource.TestCheckResourceAttrSet("keycard_zone.test", "dns_record_target"),
+					testAccCheckZoneIDSaved(&zoneID),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "keycard_zone.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Change the custom domain in place
+			{
+				Config: testAccZoneResourceConfig_withCname(rName, cname2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("keycard_zone.test", "cname", cname2),
+					resource.TestCheckResourceAttr("keycard_zone.test", "dns_record_name", cname2),
+					resource.TestCheckResourceAttrSet("keycard_zone.test", "dns_record_target"),
+					testAccCheckZoneIDUnchanged(&zoneID),
+				),
+			},
+			// Remove the custom domain
+			{
+				Config: testAccZoneResourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("keycard_zone.test", "cname"),
+					resource.TestCheckNoResourceAttr("keycard_zone.test", "dns_record_name"),
+					resource.TestCheckResourceAttrSet("keycard_zone.test", "dns_record_target"),
+					testAccCheckZoneIDUnchanged(&zoneID),
+				),
+			},
+		},
+	})
+}
+
+func testAccZoneResourceConfig_withCname(name, cname string) string {
+	return fmt.Sprintf(`
+resource "keycard_zone" "test" {
+  name  = %[1]q
+  cname = %[2]q
+}
+`, name, cname)
+}
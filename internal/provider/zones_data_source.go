@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/keycardai/terraform-provider-keycard/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ZonesDataSource{}
+
+func NewZonesDataSource() datasource.DataSource {
+	return &ZonesDataSource{}
+}
+
+// ZonesDataSource defines the data source implementation.
+type ZonesDataSource struct {
+	client *client.ClientWithResponses
+}
+
+// ZonesDataSourceModel describes the data source data model.
+type ZonesDataSourceModel struct {
+	Slug  types.String        `tfsdk:"slug"`
+	Zones []ZoneResourceModel `tfsdk:"zones"`
+}
+
+func (d *ZonesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_zones"
+}
+
+func (d *ZonesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the Keycard zones in the organization.",
+
+		Attributes: map[string]schema.Attribute{
+			"slug": schema.StringAttribute{
+				MarkdownDescription: "Only return the zone with this slug.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"zones": schema.ListNestedAttribute{
+				MarkdownDescription: "The zones in the organization.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: zoneDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *ZonesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ClientWithResponses)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ZonesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ZonesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := client.ListZonesParams{}
+	if !data.Slug.IsNull() {
+		params.Slug = data.Slug.ValueStringPointer()
+	}
+
+	// Follow the page cursor until every zone has been listed
+	data.Zones = []ZoneResourceModel{}
+	for {
+		listResp, err := d.client.ListZonesWithResponse(ctx, &params)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list zones, got error: %s", err))
+			return
+		}
+
+		if listResp.StatusCode() != 200 {
+			resp.Diagnostics.AddError(
+				"API Error",
+				fmt.Sprintf("Unable to list zones, got status %d: %s", listResp.StatusCode(), string(listResp.Body)),
+			)
+			return
+		}
+
+		if listResp.JSON200 == nil {
+			resp.Diagnostics.AddError("API Error", "Unable to list zones, no response body")
+			return
+		}
+
+		for _, zone := range listResp.JSON200.Items {
+			var zoneData ZoneResourceModel
+			resp.Diagnostics.Append(updateZoneModelFromAPIResponse(ctx, &zone, &zoneData)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			data.Zones = append(data.Zones, zoneData)
+		}
+
+		params.Cursor = nextPageCursor(listResp.JSON200.PageInfo)
+		if params.Cursor == nil {
+			break
+		}
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
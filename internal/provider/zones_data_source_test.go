@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccZonesDataSource_basic(t *testing.T) {
+	rName1 := acctest.RandomWithPrefix("tftest-zone1")
+	rName2 := acctest.RandomWithPrefix("tftest-zone2")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create two zones and list every zone in the organization
+			{
+				Config: testAccZonesDataSourceConfig_basic(rName1, rName2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.keycard_zones.test", "zones.#"),
+					// Both zones should be present in the list
+					resource.TestCheckTypeSetElemAttrPair(
+						"data.keycard_zones.test", "zones.*.id",
+						"keycard_zone.test1", "id",
+					),
+					resource.TestCheckTypeSetElemAttrPair(
+						"data.keycard_zones.test", "zones.*.id",
+						"keycard_zone.test2", "id",
+					),
+					resource.TestCheckTypeSetElemNestedAttrs("data.keycard_zones.test", "zones.*", map[string]string{
+						"name": rName1,
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.keycard_zones.test", "zones.*", map[string]string{
+						"name": rName2,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccZonesDataSourceConfig_basic(zoneName1, zoneName2 string) string {
+	return fmt.Sprintf(`
+resource "keycard_zone" "test1" {
+  name = %[1]q
+}
+
+resource "keycard_zone" "test2" {
+  name = %[2]q
+}
+
+data "keycard_zones" "test" {
+  depends_on = [keycard_zone.test1, keycard_zone.test2]
+}
+`, zoneName1, zoneName2)
+}